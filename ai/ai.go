@@ -0,0 +1,149 @@
+/*
+ * ai.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+// Package with built-in AI for area characters.
+package ai
+
+import (
+	"sync"
+
+	"github.com/isangeles/flame/area"
+	"github.com/isangeles/flame/character"
+)
+
+// Interface for AI behaviors.
+// Update returns true if behavior took control over the NPC,
+// in that case the rest of the AI behaviors is skipped.
+type Behavior interface {
+	Update(npc *NPC, delta int64) bool
+}
+
+// Type for functions usable as AI behaviors.
+type BehaviorFunc func(npc *NPC, delta int64) bool
+
+// Struct for AI.
+type AI struct {
+	behaviors []Behavior
+	npcs      *sync.Map
+}
+
+// New creates new AI with specified behaviors.
+// Behaviors are checked in the specified order,
+// if no behaviors are specified then the default
+// behaviors are used.
+func New(behaviors ...Behavior) *AI {
+	ai := AI{npcs: new(sync.Map)}
+	if len(behaviors) < 1 {
+		behaviors = DefaultBehaviors()
+	}
+	ai.behaviors = behaviors
+	return &ai
+}
+
+// DefaultBehaviors returns default AI behaviors.
+func DefaultBehaviors() []Behavior {
+	behaviors := []Behavior{
+		&TreeBehavior{},
+		&Flee{Health: 20},
+		&Companion{Range: 50},
+		&Aggro{},
+		&Attack{},
+		&Chase{Range: 500},
+		&ReturnHome{Range: 100},
+		&Wander{Range: 50, Interval: 5000},
+	}
+	return behaviors
+}
+
+// Update updates all live characters controlled by AI
+// in specified areas and their subareas.
+// NPCs of characters that were not updated are removed
+// from the AI.
+func (ai *AI) Update(delta int64, areas ...*area.Area) {
+	updated := make(map[*NPC]bool)
+	ai.update(delta, updated, areas...)
+	removeNPC := func(k, v interface{}) bool {
+		npc, ok := v.(*NPC)
+		if !ok || !updated[npc] {
+			ai.npcs.Delete(k)
+		}
+		return true
+	}
+	ai.npcs.Range(removeNPC)
+}
+
+// Behaviors returns all AI behaviors.
+func (ai *AI) Behaviors() []Behavior {
+	return ai.behaviors
+}
+
+// AddBehavior adds specified behavior to the AI.
+// Behavior is added with the lowest priority.
+func (ai *AI) AddBehavior(b Behavior) {
+	ai.behaviors = append(ai.behaviors, b)
+}
+
+// SetBehaviors sets specified behaviors as AI
+// behaviors.
+func (ai *AI) SetBehaviors(behaviors ...Behavior) {
+	ai.behaviors = behaviors
+}
+
+// update updates all live characters controlled by AI
+// in specified areas and their subareas and marks their
+// NPCs in specified map.
+func (ai *AI) update(delta int64, updated map[*NPC]bool, areas ...*area.Area) {
+	for _, a := range areas {
+		for _, ob := range a.Objects() {
+			char, ok := ob.(*character.Character)
+			if !ok || !char.AI() || !char.Live() {
+				continue
+			}
+			npc := ai.npc(char)
+			npc.area = a
+			updated[npc] = true
+			for _, b := range ai.behaviors {
+				if b.Update(npc, delta) {
+					break
+				}
+			}
+		}
+		ai.update(delta, updated, a.Subareas()...)
+	}
+}
+
+// npc returns NPC for specified character.
+func (ai *AI) npc(char *character.Character) *NPC {
+	v, _ := ai.npcs.Load(char.ID() + char.Serial())
+	npc, ok := v.(*NPC)
+	if !ok || npc.char != char {
+		npc = &NPC{char: char}
+		ai.npcs.Store(char.ID()+char.Serial(), npc)
+	}
+	return npc
+}
+
+// Update calls behavior function.
+func (f BehaviorFunc) Update(npc *NPC, delta int64) bool {
+	return f(npc, delta)
+}
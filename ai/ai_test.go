@@ -0,0 +1,228 @@
+/*
+ * ai_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package ai
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/isangeles/flame/area"
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/serial"
+	"github.com/isangeles/flame/skill"
+)
+
+var (
+	npcData     = res.CharacterData{ID: "npc", Level: 1, Attitude: string(character.Hostile)}
+	hostileData = res.CharacterData{ID: "hostile", Level: 1, Attitude: string(character.Hostile)}
+	hitData     = res.EffectData{ID: "hit", Hostile: true, MeleeHit: true}
+	attackData  = res.SkillData{ID: "attack", UseAction: res.UseActionData{
+		TargetEffects: []res.UseActionEffectData{{ID: hitData.ID}},
+	}}
+)
+
+// TestAggroAttack tests attacking hostile characters.
+func TestAggroAttack(t *testing.T) {
+	// Create test objects
+	res.Add(res.ResourcesData{Effects: []res.EffectData{hitData}})
+	a := area.New(res.AreaData{ID: "area"})
+	npc := character.New(npcData)
+	npc.SetAI(true)
+	npc.AddSkill(skill.New(attackData))
+	a.AddObject(npc)
+	hostile := character.New(hostileData)
+	hostile.SetPosition(10, 10)
+	a.AddObject(hostile)
+	ai := New()
+	// Test
+	ai.Update(1, a)
+	if len(npc.Targets()) < 1 || npc.Targets()[0] != hostile {
+		t.Fatalf("Hostile character not targeted")
+	}
+	if npc.Casted() == nil || npc.Casted().ID() != attackData.ID {
+		t.Errorf("Attack skill not used")
+	}
+}
+
+// TestRemoveNPCs tests removing NPCs of characters
+// that are not updated anymore.
+func TestRemoveNPCs(t *testing.T) {
+	// Create test objects
+	a := area.New(res.AreaData{ID: "area"})
+	npc := character.New(npcData)
+	npc.SetAI(true)
+	a.AddObject(npc)
+	dead := character.New(npcData)
+	dead.SetAI(true)
+	a.AddObject(dead)
+	ai := New()
+	npcs := func() (count int) {
+		ai.npcs.Range(func(k, v interface{}) bool {
+			count++
+			return true
+		})
+		return
+	}
+	// Test
+	ai.Update(1, a)
+	if npcs() != 2 {
+		t.Fatalf("Invalid number of NPCs: %d != 2", npcs())
+	}
+	dead.SetHealth(0)
+	ai.Update(1, a)
+	if npcs() != 1 {
+		t.Errorf("NPC of dead character not removed: %d != 1", npcs())
+	}
+	a.RemoveObject(npc)
+	ai.Update(1, a)
+	if npcs() != 0 {
+		t.Errorf("NPC of removed character not removed: %d != 0", npcs())
+	}
+}
+
+// TestAttackSkillSerials tests if checking attack skills
+// does not register new effects.
+func TestAttackSkillSerials(t *testing.T) {
+	// Create test objects
+	res.Add(res.ResourcesData{Effects: []res.EffectData{hitData}})
+	attack := skill.New(attackData)
+	registered := func() (count int) {
+		for i := 0; i < 1000; i++ {
+			if serial.Object(hitData.ID, strconv.Itoa(i)) != nil {
+				count++
+			}
+		}
+		return
+	}
+	count := registered()
+	// Test
+	for i := 0; i < 100; i++ {
+		if !attackSkill(attack) {
+			t.Fatalf("Attack skill not recognized")
+		}
+	}
+	if registered() != count {
+		t.Errorf("Effects registered while checking skill: %d != %d", registered(), count)
+	}
+}
+
+// TestFlee tests fleeing from hostile characters.
+func TestFlee(t *testing.T) {
+	// Create test objects
+	a := area.New(res.AreaData{ID: "area"})
+	npc := character.New(npcData)
+	npc.SetAI(true)
+	npc.SetPosition(10, 10)
+	npc.SetHealth(1)
+	a.AddObject(npc)
+	hostile := character.New(hostileData)
+	hostile.SetPosition(20, 10)
+	a.AddObject(hostile)
+	ai := New()
+	// Test
+	ai.Update(1, a)
+	if x, _ := npc.DestPoint(); x >= 10 {
+		t.Errorf("Invalid flee destination point: %f", x)
+	}
+}
+
+// TestReturnHome tests returning to the default position.
+func TestReturnHome(t *testing.T) {
+	// Create test objects
+	a := area.New(res.AreaData{ID: "area"})
+	npc := character.New(npcData)
+	npc.SetAI(true)
+	a.AddObject(npc)
+	npc.SetPosition(200, 200)
+	npc.SetDestPoint(200, 200)
+	npc.SetDefaultPosition(10, 20)
+	ai := New(&ReturnHome{Range: 10}, &Wander{Range: 10, Interval: 1000})
+	// Test
+	ai.Update(1, a)
+	if x, y := npc.DestPoint(); x != 10 || y != 20 {
+		t.Errorf("Invalid destination point: %fx%f != 10x20", x, y)
+	}
+}
+
+// TestBehaviorFunc tests custom behaviors.
+func TestBehaviorFunc(t *testing.T) {
+	// Create test objects
+	a := area.New(res.AreaData{ID: "area"})
+	npc := character.New(npcData)
+	npc.SetAI(true)
+	a.AddObject(npc)
+	npc.SetDefaultPosition(100, 100)
+	updated := 0
+	custom := func(npc *NPC, delta int64) bool {
+		updated++
+		return true
+	}
+	ai := New(BehaviorFunc(custom), &ReturnHome{})
+	// Test
+	ai.Update(1, a)
+	if updated != 1 {
+		t.Errorf("Invalid number of custom behavior updates: %d != 1", updated)
+	}
+	if x, y := npc.DestPoint(); x != 0 || y != 0 {
+		t.Errorf("Behavior after custom behavior was updated")
+	}
+	npc.SetAI(false)
+	ai.Update(1, a)
+	if updated != 1 {
+		t.Errorf("Character without AI was updated")
+	}
+}
+
+// TestCompanion tests executing owner commands.
+func TestCompanion(t *testing.T) {
+	// Create test objects
+	a := area.New(res.AreaData{ID: "area"})
+	owner := character.New(res.CharacterData{ID: "owner", Level: 1})
+	owner.SetPosition(100, 100)
+	a.AddObject(owner)
+	pet := character.New(npcData)
+	pet.SetAI(true)
+	owner.AddSummon(pet)
+	a.AddObject(pet)
+	enemy := character.New(res.CharacterData{ID: "enemy", Level: 1})
+	enemy.SetPosition(10, 10)
+	a.AddObject(enemy)
+	ai := New(&Companion{Range: 10}, &Aggro{}, &Chase{})
+	// Test
+	pet.SetCommand(character.Follow)
+	ai.Update(1, a)
+	if x, y := pet.DestPoint(); x != 100 || y != 100 {
+		t.Errorf("Invalid follow destination point: %fx%f != 100x100", x, y)
+	}
+	pet.SetCommand(character.Attack)
+	owner.SetTarget(enemy)
+	ai.Update(1, a)
+	if len(pet.Targets()) < 1 || pet.Targets()[0] != enemy {
+		t.Fatalf("Owner target not targeted")
+	}
+	if x, y := pet.DestPoint(); x != 10 || y != 10 {
+		t.Errorf("Invalid chase destination point: %fx%f != 10x10", x, y)
+	}
+}
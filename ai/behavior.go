@@ -0,0 +1,281 @@
+/*
+ * behavior.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package ai
+
+import (
+	"math"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/rng"
+	"github.com/isangeles/flame/skill"
+)
+
+// Behavior for running behavior trees of NPC characters.
+type TreeBehavior struct{}
+
+// Behavior for fleeing from hostile characters when
+// NPC health is low.
+type Flee struct {
+	// Percentage of the maximal health below which NPC flees.
+	Health int
+}
+
+// Behavior for executing owner commands by owned
+// characters.
+type Companion struct {
+	// Distance from the owner after which NPC follows
+	// the owner.
+	Range float64
+}
+
+// Behavior for attacking hostile characters in sight.
+type Aggro struct{}
+
+// Behavior for attacking current target with skills.
+type Attack struct{}
+
+// Behavior for chasing current target.
+type Chase struct {
+	// Maximal distance from the NPC default position,
+	// NPC drops target after moving further.
+	// Zero means no limit.
+	Range float64
+}
+
+// Behavior for returning to the default position.
+type ReturnHome struct {
+	// Distance from the default position after which
+	// NPC returns.
+	Range float64
+}
+
+// Behavior for idle wandering around the default position.
+type Wander struct {
+	// Maximal distance from the default position.
+	Range float64
+	// Time between picking new wander points(in milliseconds).
+	Interval int64
+}
+
+// Update runs behavior tree of the NPC character.
+// Takes control over the NPC if the tree did not fail.
+// Saves tree state in the NPC character.
+func (tb *TreeBehavior) Update(npc *NPC, delta int64) bool {
+	char := npc.Character()
+	if len(char.BehaviorTree()) < 1 {
+		return false
+	}
+	if npc.tree == nil || npc.tree.ID() != char.BehaviorTree() {
+		data := res.BehaviorTree(char.BehaviorTree())
+		if data == nil {
+			log.Err.Printf("AI: %s %s: behavior tree data not found: %s",
+				char.ID(), char.Serial(), char.BehaviorTree())
+			npc.tree = &Tree{id: char.BehaviorTree()}
+			return false
+		}
+		npc.tree = NewTree(*data)
+		npc.tree.Apply(char.TreeState())
+	}
+	if npc.tree.Root() == nil {
+		return false
+	}
+	status := npc.tree.Update(npc, delta)
+	char.SetTreeState(npc.tree.State())
+	return status != Failure
+}
+
+// Update moves NPC away from the nearest hostile character
+// if NPC health is below the flee value.
+func (f *Flee) Update(npc *NPC, delta int64) bool {
+	char := npc.Character()
+	if char.MaxHealth() < 1 || char.Health()*100/char.MaxHealth() >= f.Health {
+		return false
+	}
+	hostile := npc.NearestHostile()
+	if hostile == nil {
+		return false
+	}
+	x, y := char.Position()
+	hostX, hostY := hostile.Position()
+	dist := math.Max(npc.Distance(hostX, hostY), 1)
+	npc.MoveTo(x+(x-hostX)/dist*char.SightRange(), y+(y-hostY)/dist*char.SightRange())
+	return true
+}
+
+// Update executes owner command if NPC has an owner.
+// Sets owner position as NPC default position.
+// Follow command makes NPC follow the owner and ignore enemies,
+// guard command makes NPC attack owner attackers and attack
+// command makes NPC attack owner target.
+// Takes control over the NPC if NPC is following the owner.
+func (c *Companion) Update(npc *NPC, delta int64) bool {
+	char := npc.Character()
+	owner := char.Owner()
+	if owner == nil {
+		return false
+	}
+	char.SetDefaultPosition(owner.Position())
+	switch char.Command() {
+	case character.Attack:
+		if tar, ok := ownerTarget(owner); ok && npc.hostile(tar) {
+			char.SetTarget(tar)
+		}
+		fallthrough
+	case character.Guard:
+		top, ok := owner.HighestThreat().(*character.Character)
+		if npc.Target() == nil && ok && npc.hostile(top) {
+			char.SetTarget(top)
+		}
+		if npc.Target() != nil {
+			return false
+		}
+	default:
+		char.SetTarget(nil)
+	}
+	if npc.HomeDistance() > c.Range {
+		npc.MoveTo(owner.Position())
+	}
+	return true
+}
+
+// Update sets character with the highest threat as NPC target,
+// if there is no such character in sight then sets the nearest
+// hostile character as NPC target if NPC has no hostile target
+// already.
+// Never takes control over the NPC.
+func (a *Aggro) Update(npc *NPC, delta int64) bool {
+	char := npc.Character()
+	top, ok := char.HighestThreat().(*character.Character)
+	if ok && npc.hostile(top) {
+		char.SetTarget(top)
+		return false
+	}
+	if npc.Target() != nil {
+		return false
+	}
+	if hostile := npc.NearestHostile(); hostile != nil {
+		char.SetTarget(hostile)
+	}
+	return false
+}
+
+// Update uses attack skills on the current NPC target.
+// Takes control if NPC is casting, attacking or waiting
+// for skill cooldown with target in range.
+func (a *Attack) Update(npc *NPC, delta int64) bool {
+	char := npc.Character()
+	if npc.Target() == nil {
+		return false
+	}
+	if char.Casted() != nil {
+		return true
+	}
+	destX, destY := char.DestPoint()
+	npc.Stop()
+	inRange := false
+	for _, s := range char.Skills() {
+		if !attackSkill(s) {
+			continue
+		}
+		err := char.Use(s)
+		if err == nil {
+			return true
+		}
+		if err != character.REQS_NOT_MEET {
+			inRange = true
+		}
+	}
+	if !inRange {
+		npc.MoveTo(destX, destY)
+	}
+	return inRange
+}
+
+// Update moves NPC to the current target.
+// Drops the target if NPC is too far from the default
+// position.
+func (c *Chase) Update(npc *NPC, delta int64) bool {
+	tar := npc.Target()
+	if tar == nil {
+		return false
+	}
+	if c.Range > 0 && npc.HomeDistance() > c.Range {
+		npc.Character().SetTarget(nil)
+		return false
+	}
+	npc.MoveTo(tar.Position())
+	return true
+}
+
+// Update moves NPC to the default position if NPC is
+// too far from it.
+func (rh *ReturnHome) Update(npc *NPC, delta int64) bool {
+	if npc.HomeDistance() <= rh.Range {
+		return false
+	}
+	npc.MoveTo(npc.Character().DefaultPosition())
+	return true
+}
+
+// Update moves NPC to random position around the default
+// position after each wander interval.
+func (w *Wander) Update(npc *NPC, delta int64) bool {
+	npc.wanderTime -= delta
+	if npc.wanderTime > 0 || npc.Character().Moving() {
+		return true
+	}
+	npc.wanderTime = w.Interval
+	x, y := npc.Character().DefaultPosition()
+	r := int(w.Range)
+	npc.MoveTo(x+float64(rng.RollInt(0, 2*r)-r), y+float64(rng.RollInt(0, 2*r)-r))
+	return true
+}
+
+// ownerTarget returns current character target of
+// specified owner.
+func ownerTarget(owner *character.Character) (*character.Character, bool) {
+	if len(owner.Targets()) < 1 {
+		return nil, false
+	}
+	tar, ok := owner.Targets()[0].(*character.Character)
+	return tar, ok
+}
+
+// attackSkill checks if specified skill can be used
+// to attack.
+// Skill is checked with data of target effects, so no
+// new effects are created.
+func attackSkill(s *skill.Skill) bool {
+	if s.UseAction() == nil {
+		return false
+	}
+	for _, ed := range s.UseAction().TargetEffectsData() {
+		if ed.Hostile {
+			return true
+		}
+	}
+	return false
+}
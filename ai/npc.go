@@ -0,0 +1,136 @@
+/*
+ * npc.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package ai
+
+import (
+	"math"
+
+	"github.com/isangeles/flame/area"
+	"github.com/isangeles/flame/character"
+)
+
+// Struct for character controlled by AI.
+type NPC struct {
+	char       *character.Character
+	area       *area.Area
+	tree       *Tree
+	wanderTime int64
+}
+
+// Character returns NPC character.
+func (npc *NPC) Character() *character.Character {
+	return npc.char
+}
+
+// Area returns area where NPC is present.
+func (npc *NPC) Area() *area.Area {
+	return npc.area
+}
+
+// Target returns current hostile target of the NPC,
+// or nil if NPC has no live, hostile target in sight.
+func (npc *NPC) Target() *character.Character {
+	if len(npc.char.Targets()) < 1 {
+		return nil
+	}
+	tar, ok := npc.char.Targets()[0].(*character.Character)
+	if !ok || !npc.hostile(tar) {
+		return nil
+	}
+	return tar
+}
+
+// Hostiles returns all live, hostile characters in
+// the NPC sight range.
+func (npc *NPC) Hostiles() (chars []*character.Character) {
+	if npc.area == nil {
+		return
+	}
+	x, y := npc.char.Position()
+	for _, ob := range npc.area.NearObjects(x, y, npc.char.SightRange()) {
+		char, ok := ob.(*character.Character)
+		if ok && npc.hostile(char) {
+			chars = append(chars, char)
+		}
+	}
+	return
+}
+
+// NearestHostile returns the nearest live, hostile
+// character in the NPC sight range, or nil if there
+// is no such character.
+func (npc *NPC) NearestHostile() (nearest *character.Character) {
+	for _, c := range npc.Hostiles() {
+		if nearest == nil || npc.Distance(c.Position()) < npc.Distance(nearest.Position()) {
+			nearest = c
+		}
+	}
+	return
+}
+
+// Distance returns distance between NPC and specified
+// XY position.
+func (npc *NPC) Distance(x, y float64) float64 {
+	posX, posY := npc.char.Position()
+	return math.Hypot(posX-x, posY-y)
+}
+
+// HomeDistance returns distance between NPC and its
+// default position.
+func (npc *NPC) HomeDistance() float64 {
+	return npc.Distance(npc.char.DefaultPosition())
+}
+
+// MoveTo sets specified XY position as NPC destination
+// point.
+func (npc *NPC) MoveTo(x, y float64) {
+	npc.char.SetDestPoint(x, y)
+}
+
+// Stop stops NPC movement.
+func (npc *NPC) Stop() {
+	npc.char.SetDestPoint(npc.char.Position())
+}
+
+// hostile checks if specified character is a live,
+// hostile character in the NPC sight.
+// Characters from the NPC threat table are always hostile.
+// For owned NPCs characters from the owner threat table and
+// the owner target, in case of attack command, are also hostile.
+func (npc *NPC) hostile(char *character.Character) bool {
+	owner := npc.char.Owner()
+	if char == npc.char || char == owner || !char.Live() {
+		return false
+	}
+	enemy := npc.char.AttitudeFor(char) == character.Hostile || npc.char.Threat(char) > 0
+	if owner != nil && !enemy {
+		tar, _ := ownerTarget(owner)
+		enemy = owner.Threat(char) > 0 ||
+			(npc.char.Command() == character.Attack && tar == char)
+	}
+	if !enemy {
+		return false
+	}
+	return npc.char.InSight(char.Position())
+}
@@ -0,0 +1,310 @@
+/*
+ * tree.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package ai
+
+import (
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/objects"
+	"github.com/isangeles/flame/req"
+)
+
+// Type for behavior tree node status.
+type Status int
+
+// Type for behavior tree node type.
+type NodeType string
+
+const (
+	Success Status = iota
+	Failure
+	Running
+)
+
+const (
+	// Composite nodes.
+	Sequence = NodeType("sequence")
+	Selector = NodeType("selector")
+	// Decorator nodes.
+	Inverter  = NodeType("inverter")
+	Succeeder = NodeType("succeeder")
+	Cooldown  = NodeType("cooldown")
+	// Condition nodes.
+	Condition = NodeType("condition")
+	// Action nodes.
+	Move     = NodeType("move")
+	UseSkill = NodeType("use-skill")
+	Talk     = NodeType("talk")
+	Modifier = NodeType("modifier")
+	Wait     = NodeType("wait")
+)
+
+// Struct for behavior tree.
+type Tree struct {
+	id   string
+	root *Node
+}
+
+// Struct for behavior tree node.
+type Node struct {
+	nodeType   NodeType
+	posX, posY float64
+	skill      string
+	text       string
+	time       int64 // millis
+	target     bool
+	reqs       []req.Requirement
+	mods       []effect.Modifier
+	nodes      []*Node
+	child      int
+	timer      int64 // millis
+}
+
+// NewTree creates new behavior tree.
+// Tree without root node type specified has no root node.
+func NewTree(data res.BehaviorTreeData) *Tree {
+	t := Tree{id: data.ID}
+	if len(data.Root.Type) > 0 {
+		t.root = newNode(data.Root)
+	}
+	return &t
+}
+
+// ID returns tree ID.
+func (t *Tree) ID() string {
+	return t.id
+}
+
+// Root returns tree root node or nil if the tree
+// has no root node.
+func (t *Tree) Root() *Node {
+	return t.root
+}
+
+// Update runs tree for specified NPC and returns
+// the status of the root node.
+// Returns failure if the tree has no root node.
+func (t *Tree) Update(npc *NPC, delta int64) Status {
+	if t.root == nil {
+		return Failure
+	}
+	return t.root.update(npc, delta)
+}
+
+// Apply applies specified state on the tree nodes.
+// State contains values for the tree nodes in
+// depth-first order.
+func (t *Tree) Apply(state []res.BehaviorStateData) {
+	if t.root == nil {
+		return
+	}
+	i := 0
+	for _, n := range t.root.all() {
+		if i >= len(state) {
+			return
+		}
+		n.child, n.timer = state[i].Child, state[i].Time
+		i++
+	}
+}
+
+// State returns state of the tree nodes in
+// depth-first order.
+func (t *Tree) State() (state []res.BehaviorStateData) {
+	if t.root == nil {
+		return
+	}
+	for _, n := range t.root.all() {
+		state = append(state, res.BehaviorStateData{Child: n.child, Time: n.timer})
+	}
+	return
+}
+
+// newNode creates new behavior tree node.
+// Logs an error if the node type is unknown, nodes of
+// unknown type always fail.
+func newNode(data res.BehaviorNodeData) *Node {
+	n := Node{
+		nodeType: NodeType(data.Type),
+		posX:     data.PosX,
+		posY:     data.PosY,
+		skill:    data.Skill,
+		text:     data.Text,
+		time:     data.Time,
+		target:   data.Target,
+		reqs:     req.NewRequirements(data.Reqs),
+		mods:     effect.NewModifiers(data.Modifiers),
+	}
+	switch n.nodeType {
+	case Sequence, Selector, Inverter, Succeeder, Cooldown, Condition,
+		Move, UseSkill, Talk, Modifier, Wait:
+	default:
+		log.Err.Printf("AI: behavior tree: unknown node type: %s", n.nodeType)
+	}
+	for _, nd := range data.Nodes {
+		n.nodes = append(n.nodes, newNode(nd))
+	}
+	return &n
+}
+
+// Type returns node type.
+func (n *Node) Type() NodeType {
+	return n.nodeType
+}
+
+// Nodes returns all child nodes.
+func (n *Node) Nodes() []*Node {
+	return n.nodes
+}
+
+// update runs node for specified NPC and returns
+// node status.
+func (n *Node) update(npc *NPC, delta int64) Status {
+	switch n.nodeType {
+	case Sequence:
+		return n.updateComposite(npc, delta, Success)
+	case Selector:
+		return n.updateComposite(npc, delta, Failure)
+	case Inverter:
+		switch n.updateChild(npc, delta) {
+		case Success:
+			return Failure
+		case Failure:
+			return Success
+		}
+		return Running
+	case Succeeder:
+		if n.updateChild(npc, delta) == Running {
+			return Running
+		}
+		return Success
+	case Cooldown:
+		if n.timer > 0 {
+			n.timer -= delta
+			return Failure
+		}
+		status := n.updateChild(npc, delta)
+		if status != Running {
+			n.timer = n.time
+		}
+		return status
+	case Condition:
+		if npc.Character().MeetReqs(n.reqs...) {
+			return Success
+		}
+		return Failure
+	case Move:
+		if npc.Distance(n.posX, n.posY) < 1 {
+			return Success
+		}
+		npc.MoveTo(n.posX, n.posY)
+		return Running
+	case UseSkill:
+		return n.useSkill(npc)
+	case Talk:
+		npc.Character().ChatLog().Add(objects.NewMessage(n.text, false))
+		return Success
+	case Modifier:
+		if !n.target {
+			npc.Character().TakeModifiers(npc.Character(), n.mods...)
+			return Success
+		}
+		tar := npc.Target()
+		if tar == nil {
+			return Failure
+		}
+		tar.TakeModifiers(npc.Character(), n.mods...)
+		return Success
+	case Wait:
+		n.timer += delta
+		if n.timer < n.time {
+			return Running
+		}
+		n.timer = 0
+		return Success
+	default:
+		return Failure
+	}
+}
+
+// updateComposite runs child nodes one after another,
+// starting from the last running child node, until
+// one of the child nodes returns status different
+// than specified one.
+func (n *Node) updateComposite(npc *NPC, delta int64, next Status) Status {
+	for ; n.child < len(n.nodes); n.child++ {
+		status := n.nodes[n.child].update(npc, delta)
+		if status == Running {
+			return Running
+		}
+		if status != next {
+			n.child = 0
+			return status
+		}
+	}
+	n.child = 0
+	return next
+}
+
+// updateChild runs the first child node and returns
+// its status, returns failure if there is no child
+// node.
+func (n *Node) updateChild(npc *NPC, delta int64) Status {
+	if len(n.nodes) < 1 {
+		return Failure
+	}
+	return n.nodes[0].update(npc, delta)
+}
+
+// useSkill uses node skill on the current NPC target.
+func (n *Node) useSkill(npc *NPC) Status {
+	char := npc.Character()
+	if c := char.Casted(); c != nil {
+		if c.ID() == n.skill {
+			return Running
+		}
+		return Failure
+	}
+	for _, s := range char.Skills() {
+		if s.ID() != n.skill {
+			continue
+		}
+		if char.Use(s) != nil {
+			return Failure
+		}
+		return Success
+	}
+	return Failure
+}
+
+// all returns node and all its descendant nodes
+// in depth-first order.
+func (n *Node) all() []*Node {
+	nodes := []*Node{n}
+	for _, c := range n.nodes {
+		nodes = append(nodes, c.all()...)
+	}
+	return nodes
+}
@@ -0,0 +1,140 @@
+/*
+ * tree_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package ai
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/area"
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/flag"
+)
+
+var patrolData = res.BehaviorTreeData{
+	ID: "patrol",
+	Root: res.BehaviorNodeData{Type: string(Selector), Nodes: []res.BehaviorNodeData{
+		{Type: string(Sequence), Nodes: []res.BehaviorNodeData{
+			{Type: string(Condition), Reqs: res.ReqsData{FlagReqs: []res.IDReqData{{ID: "guard"}}}},
+			{Type: string(Move), PosX: 10, PosY: 10},
+			{Type: string(Talk), Text: "clear"},
+		}},
+		{Type: string(Inverter), Nodes: []res.BehaviorNodeData{
+			{Type: string(Modifier), Modifiers: res.ModifiersData{
+				FlagMods: []res.FlagModData{{ID: "guard"}},
+			}},
+		}},
+	}},
+}
+
+// TestTreeBehavior tests running behavior trees.
+func TestTreeBehavior(t *testing.T) {
+	// Create test objects
+	res.Add(res.ResourcesData{BehaviorTrees: []res.BehaviorTreeData{patrolData}})
+	a := area.New(res.AreaData{ID: "area"})
+	data := npcData
+	data.BehaviorTree = patrolData.ID
+	npc := character.New(data)
+	npc.SetAI(true)
+	a.AddObject(npc)
+	ai := New(&TreeBehavior{})
+	// Test
+	ai.Update(1, a)
+	if !npc.HasFlag(flag.Flag("guard")) {
+		t.Fatalf("Modifier node was not run")
+	}
+	ai.Update(1, a)
+	if x, y := npc.DestPoint(); x != 10 || y != 10 {
+		t.Fatalf("Invalid destination point: %fx%f != 10x10", x, y)
+	}
+	state := npc.TreeState()
+	if len(state) != 7 || state[1].Child != 1 {
+		t.Fatalf("Invalid tree state: %v", state)
+	}
+	npc.SetPosition(10, 10)
+	ai.Update(1, a)
+	if len(npc.ChatLog().Messages()) != 1 {
+		t.Errorf("Talk node was not run")
+	}
+	if npc.TreeState()[1].Child != 0 {
+		t.Errorf("Invalid sequence state: %d != 0", npc.TreeState()[1].Child)
+	}
+}
+
+// TestTreeState tests restoring behavior tree state.
+func TestTreeState(t *testing.T) {
+	// Create test objects
+	tree := NewTree(patrolData)
+	state := []res.BehaviorStateData{{}, {Child: 2}}
+	// Test
+	tree.Apply(state)
+	if tree.Root().Nodes()[0].child != 2 {
+		t.Errorf("Invalid sequence state: %d != 2", tree.Root().Nodes()[0].child)
+	}
+	if len(tree.State()) != 7 || tree.State()[1].Child != 2 {
+		t.Errorf("Invalid tree state: %v", tree.State())
+	}
+}
+
+// TestTreeEmpty tests behavior tree without root node.
+func TestTreeEmpty(t *testing.T) {
+	// Create test objects
+	tree := NewTree(res.BehaviorTreeData{ID: "empty"})
+	npc := &NPC{char: character.New(npcData)}
+	// Test
+	if tree.Root() != nil {
+		t.Errorf("Root node created for empty tree")
+	}
+	if status := tree.Update(npc, 1); status != Failure {
+		t.Errorf("Invalid status: %v != %v", status, Failure)
+	}
+	tree.Apply([]res.BehaviorStateData{{Child: 1}})
+	if len(tree.State()) != 0 {
+		t.Errorf("Invalid tree state: %v", tree.State())
+	}
+}
+
+// TestTreeCooldown tests cooldown decorator.
+func TestTreeCooldown(t *testing.T) {
+	// Create test objects
+	data := res.BehaviorTreeData{
+		ID: "cooldown",
+		Root: res.BehaviorNodeData{Type: string(Cooldown), Time: 100, Nodes: []res.BehaviorNodeData{
+			{Type: string(Talk), Text: "hello"},
+		}},
+	}
+	tree := NewTree(data)
+	npc := &NPC{char: character.New(npcData)}
+	// Test
+	if status := tree.Update(npc, 1); status != Success {
+		t.Errorf("Invalid status: %v != %v", status, Success)
+	}
+	if status := tree.Update(npc, 50); status != Failure {
+		t.Errorf("Invalid status: %v != %v", status, Failure)
+	}
+	tree.Update(npc, 50)
+	if status := tree.Update(npc, 1); status != Success {
+		t.Errorf("Invalid status: %v != %v", status, Success)
+	}
+}
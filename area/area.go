@@ -30,26 +30,47 @@ import (
 	"time"
 
 	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/clock"
 	"github.com/isangeles/flame/data/res"
 	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/flag"
 	"github.com/isangeles/flame/item"
 	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/object"
 	"github.com/isangeles/flame/serial"
 )
 
 // List with names of the map layers on which the
 // area objects can move.
+// Used only for map layers without 'passable' property
+// when the map has no 'passable-layers' property.
 var PassableMapLayers = []string{"ground"}
 
 // Area struct represents game world area.
 type Area struct {
-	id       string
-	Time     time.Time
-	weather  *Weather
-	areaMap  Map
-	spawn    *Spawn
-	objects  *sync.Map
-	subareas *sync.Map
+	id             string
+	clock          *clock.Clock
+	sharedClock    bool
+	world          World
+	weather        *Weather
+	areaMap        Map
+	spawn          *Spawn
+	spawnPoints    []res.SpawnPointData
+	objects        *sync.Map
+	subareas       *sync.Map
+	paths          *sync.Map
+	portals        *sync.Map
+	triggers       *sync.Map
+	piles          *sync.Map
+	itemDespawn    int64
+	onTriggerEnter func(t *Trigger, o Object)
+	onTriggerLeave func(t *Trigger, o Object)
+	onTriggerStay  func(t *Trigger, o Object)
+}
+
+// Interface for world with world flags.
+type World interface {
+	HasFlag(f flag.Flag) bool
 }
 
 // Interface for area objects.
@@ -73,11 +94,23 @@ type Object interface {
 	Inventory() *item.Inventory
 }
 
+// Interface for area objects that can collide
+// with other objects.
+type Collider interface {
+	CollisionRadius() float64
+	Blocking() bool
+}
+
 // New creates new area.
 func New(data res.AreaData) *Area {
 	a := new(Area)
 	a.objects = new(sync.Map)
 	a.subareas = new(sync.Map)
+	a.paths = new(sync.Map)
+	a.portals = new(sync.Map)
+	a.triggers = new(sync.Map)
+	a.piles = new(sync.Map)
+	a.clock = clock.New(res.ClockData{})
 	a.weather = newWeather(a)
 	a.spawn = newSpawn(a)
 	a.Apply(data)
@@ -86,8 +119,10 @@ func New(data res.AreaData) *Area {
 
 // Update updates area.
 func (a *Area) Update(delta int64) {
-	a.Time = a.Time.Add(time.Duration(delta) * time.Millisecond)
-	a.Weather().update()
+	if !a.sharedClock {
+		a.clock.Update(delta)
+	}
+	a.Weather().update(delta)
 	for _, o := range a.Objects() {
 		o.Update(delta)
 		// Move to dest point.
@@ -95,11 +130,21 @@ func (a *Area) Update(delta int64) {
 			x, y := o.DestPoint()
 			a.moveObject(o, x, y)
 		}
+		a.updateTriggers(o, delta)
+		if c, ok := o.(*character.Character); ok {
+			a.callForHelp(c)
+			a.updateSummons(c)
+		}
 	}
+	for _, p := range a.Portals() {
+		p.update(delta)
+	}
+	a.updateItemPiles(delta)
 	for _, sa := range a.Subareas() {
 		sa.Update(delta)
 	}
-	a.spawn.Update()
+	a.spawn.Update(delta)
+	a.spawn.updateSpawners(delta)
 }
 
 // ID returns area ID.
@@ -118,16 +163,37 @@ func (a *Area) AddObject(o Object) {
 	o.SetAreaID(a.ID())
 	posX, posY := o.Position()
 	o.SetDestPoint(posX, posY)
+	if c, ok := o.(*character.Character); ok {
+		c.SetEnvironment(a)
+	}
+	for _, p := range a.Portals() {
+		if p.Region().Contains(posX, posY) {
+			p.objects.Store(o.ID()+o.Serial(), o)
+		}
+	}
 }
 
 // RemoveObject removes specified object from area.
 func (a *Area) RemoveObject(o Object) {
 	a.objects.Delete(o.ID() + o.Serial())
+	a.paths.Delete(o.ID() + o.Serial())
+	for _, t := range a.Triggers() {
+		t.objects.Delete(o.ID() + o.Serial())
+	}
+	for _, p := range a.Portals() {
+		p.objects.Delete(o.ID() + o.Serial())
+	}
+	a.weather.removeObject(o)
+	if c, ok := o.(*character.Character); ok && c.Environment() == a {
+		c.SetEnvironment(nil)
+	}
 }
 
 // AddSubareas adds specified area to subareas.
 func (a *Area) AddSubarea(sa *Area) {
 	a.subareas.Store(sa.ID(), sa)
+	sa.SetClock(a.Clock())
+	sa.SetWorld(a.World())
 }
 
 // RemoveSubareas removes specified subobject.
@@ -182,6 +248,123 @@ func (a *Area) AllSubareas() (subareas []*Area) {
 	return
 }
 
+// AddPortal adds specified portal to the area.
+func (a *Area) AddPortal(p *Portal) {
+	a.portals.Store(p.ID(), p)
+}
+
+// Portals returns all area portals.
+func (a *Area) Portals() (portals []*Portal) {
+	addPortal := func(k, v interface{}) bool {
+		p, ok := v.(*Portal)
+		if ok {
+			portals = append(portals, p)
+		}
+		return true
+	}
+	a.portals.Range(addPortal)
+	return
+}
+
+// AddTrigger adds specified trigger to the area.
+func (a *Area) AddTrigger(t *Trigger) {
+	a.triggers.Store(t.ID(), t)
+}
+
+// Triggers returns all area triggers.
+func (a *Area) Triggers() (triggers []*Trigger) {
+	addTrigger := func(k, v interface{}) bool {
+		t, ok := v.(*Trigger)
+		if ok {
+			triggers = append(triggers, t)
+		}
+		return true
+	}
+	a.triggers.Range(addTrigger)
+	return
+}
+
+// SpawnPoint returns position of the area spawn point
+// with specified ID.
+// Returns false if there is no such spawn point.
+func (a *Area) SpawnPoint(id string) (float64, float64, bool) {
+	for _, sp := range a.spawnPoints {
+		if sp.ID == id {
+			return sp.X, sp.Y, true
+		}
+	}
+	return 0, 0, false
+}
+
+// SetOnTriggerEnterFunc sets function triggered after
+// area object entered the trigger region.
+func (a *Area) SetOnTriggerEnterFunc(f func(t *Trigger, o Object)) {
+	a.onTriggerEnter = f
+}
+
+// SetOnTriggerLeaveFunc sets function triggered after
+// area object left the trigger region.
+func (a *Area) SetOnTriggerLeaveFunc(f func(t *Trigger, o Object)) {
+	a.onTriggerLeave = f
+}
+
+// SetOnTriggerStayFunc sets function triggered after
+// applying stay modifiers and effects of the trigger
+// on the area object.
+func (a *Area) SetOnTriggerStayFunc(f func(t *Trigger, o Object)) {
+	a.onTriggerStay = f
+}
+
+// Clock returns area clock.
+func (a *Area) Clock() *clock.Clock {
+	return a.clock
+}
+
+// SetClock sets specified clock as area clock.
+// Area does not update the clock set with this function,
+// so clock can be shared between many areas.
+func (a *Area) SetClock(c *clock.Clock) {
+	a.clock = c
+	a.sharedClock = true
+	for _, sa := range a.Subareas() {
+		sa.SetClock(c)
+	}
+}
+
+// World returns world of the area.
+func (a *Area) World() World {
+	return a.world
+}
+
+// SetWorld sets specified world as world of the area
+// and all subareas.
+func (a *Area) SetWorld(w World) {
+	a.world = w
+	for _, sa := range a.Subareas() {
+		sa.SetWorld(w)
+	}
+}
+
+// HasWorldFlag checks if world of the area has specified
+// world flag.
+func (a *Area) HasWorldFlag(f flag.Flag) bool {
+	if a.World() == nil {
+		return false
+	}
+	return a.World().HasFlag(f)
+}
+
+// SightMod returns modifier for sight range of characters
+// on specified position, depending on the current day
+// phase.
+func (a *Area) SightMod(x, y float64) float64 {
+	tile, ok := a.Map().PositionTile(x, y)
+	if ok && tile.Properties().Indoor {
+		return 0
+	}
+	return a.Clock().SightMod()
+}
+
 // Weather retuns area weather.
 func (a *Area) Weather() *Weather {
 	return a.weather
@@ -202,8 +385,41 @@ func (a *Area) NearObjects(x, y, maxrange float64) (obs []Object) {
 	return
 }
 
+// callForHelp alerts all living allies of specified character
+// within the character help range about character attackers.
+func (a *Area) callForHelp(char *character.Character) {
+	if len(char.HelpCalls()) < 1 {
+		return
+	}
+	defer char.ClearHelpCalls()
+	x, y := char.Position()
+	for _, o := range a.NearObjects(x, y, char.HelpRange()) {
+		ally, ok := o.(*character.Character)
+		if !ok || !ally.Live() || !char.Ally(ally) {
+			continue
+		}
+		for _, m := range char.HelpCalls() {
+			ally.Alert(m)
+		}
+	}
+}
+
+// updateSummons adds characters summoned by specified character
+// to the area and removes the character from the area if it
+// is an expired summon.
+func (a *Area) updateSummons(char *character.Character) {
+	for _, s := range char.SummonQueue() {
+		a.AddObject(s)
+	}
+	char.ClearSummonQueue()
+	if char.Expired() {
+		a.RemoveObject(char)
+		serial.Unregister(char)
+	}
+}
+
 // SightRangeObjects retuns all objects that have specified XY position
-// in their sight range.
+// in their sight range, with clear line of sight to this position.
 func (a *Area) SightRangeObjects(x, y float64) (obs []Object) {
 	addObject := func(k, v interface{}) bool {
 		ob, ok := v.(Object)
@@ -211,7 +427,8 @@ func (a *Area) SightRangeObjects(x, y float64) (obs []Object) {
 			return true
 		}
 		obX, obY := ob.Position()
-		if math.Hypot(obX-x, obY-y) <= ob.SightRange() {
+		if math.Hypot(obX-x, obY-y) <= ob.SightRange() &&
+			a.LineOfSight(obX, obY, x, y) {
 			obs = append(obs, ob)
 		}
 		return true
@@ -223,14 +440,56 @@ func (a *Area) SightRangeObjects(x, y float64) (obs []Object) {
 // Apply applies specified data on the area.
 func (a *Area) Apply(data res.AreaData) {
 	a.id = data.ID
-	a.Time, _ = time.Parse(time.Kitchen, data.Time)
+	if t, err := time.Parse(time.Kitchen, data.Time); err == nil && !a.sharedClock {
+		dayTime := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+		a.clock.SetTimeOfDay(dayTime.Milliseconds())
+	}
+	a.weather.SetTable(data.WeatherTable)
 	a.weather.Conditions = Conditions(data.Weather)
+	weatherTime := data.WeatherTime
+	if weatherTime == 0 && len(a.weather.Conditions) > 0 {
+		weatherTime = conditionsTimer
+		if c := a.weather.conditions(a.weather.Conditions); c != nil {
+			weatherTime = c.duration()
+		}
+	}
+	a.weather.SetTime(weatherTime)
+	a.weather.SetSeason(data.Season)
 	if data.Map != nil {
 		a.areaMap = newMap(data.Map)
+		a.paths = new(sync.Map)
 	}
-	a.spawn.Apply(data.Spawn)
+	// Map objects.
+	if !data.Restore {
+		data.Characters = append(data.Characters, a.Map().characters...)
+		data.Objects = append(data.Objects, a.Map().objects...)
+	}
+	a.spawnPoints = nil
+	for _, spd := range a.Map().spawnPoints {
+		a.addSpawnPoint(spd)
+	}
+	for _, spd := range data.SpawnPoints {
+		a.addSpawnPoint(spd)
+	}
+	for _, pd := range a.Map().portals {
+		a.applyPortal(pd)
+	}
+	for _, pd := range data.Portals {
+		a.applyPortal(pd)
+	}
+	for _, td := range a.Map().triggers {
+		a.applyTrigger(td)
+	}
+	for _, td := range data.Triggers {
+		a.applyTrigger(td)
+	}
+	a.itemDespawn = data.ItemDespawn
+	if a.itemDespawn == 0 {
+		a.itemDespawn = DefaultItemDespawn
+	}
+	a.applyItemPiles(data.ItemPiles)
 	// Remove objects not present anymore.
-	removeChars := func(key, value interface{}) bool {
+	removeObjects := func(key, value interface{}) bool {
 		key, _ = key.(string)
 		found := false
 		for _, cd := range data.Characters {
@@ -239,12 +498,18 @@ func (a *Area) Apply(data res.AreaData) {
 				break
 			}
 		}
+		for _, od := range data.Objects {
+			if od.ID+od.Serial == key {
+				found = true
+				break
+			}
+		}
 		if !found {
 			a.objects.Delete(key)
 		}
 		return true
 	}
-	a.objects.Range(removeChars)
+	a.objects.Range(removeObjects)
 	// Characters.
 	for _, areaCharData := range data.Characters {
 		// Retireve char data.
@@ -271,6 +536,7 @@ func (a *Area) Apply(data res.AreaData) {
 		}
 		char.SetRespawn(areaCharData.Respawn)
 		char.SetDespawn(areaCharData.Despawn)
+		char.SetAI(areaCharData.AI)
 		// Set position.
 		if areaCharData.InitX > 0 && areaCharData.InitY > 0 {
 			char.SetPosition(areaCharData.InitX, areaCharData.InitY)
@@ -282,6 +548,11 @@ func (a *Area) Apply(data res.AreaData) {
 		char.SetDestPoint(areaCharData.DestX, areaCharData.DestY)
 		char.SetDefaultPosition(areaCharData.DefX, areaCharData.DefY)
 	}
+	// Objects.
+	for _, objData := range data.Objects {
+		a.applyObject(objData)
+	}
+	a.spawn.Apply(data.Spawn)
 	// Subareas.
 	for _, subareaData := range data.Subareas {
 		v, _ := a.subareas.Load(subareaData.ID)
@@ -297,10 +568,26 @@ func (a *Area) Apply(data res.AreaData) {
 // Data returns area data resource.
 func (a *Area) Data() res.AreaData {
 	data := res.AreaData{
-		ID:    a.ID(),
-		Time:  a.Time.Format(time.Kitchen),
-		Spawn: a.spawn.Data(),
-		Map:   a.areaMap.Data(),
+		ID:          a.ID(),
+		Time:        a.timeOfDay().Format(time.Kitchen),
+		Restore:     true,
+		Spawn:       a.spawn.Data(),
+		Map:         a.areaMap.Data(),
+		ItemDespawn: a.ItemDespawn(),
+		Weather:     string(a.Weather().Conditions),
+		WeatherTime: a.Weather().Time(),
+		Season:      a.Weather().Season(),
+	}
+	data.WeatherTable = a.Weather().TableData()
+	data.SpawnPoints = append(data.SpawnPoints, a.spawnPoints...)
+	for _, p := range a.Portals() {
+		data.Portals = append(data.Portals, p.Data())
+	}
+	for _, t := range a.Triggers() {
+		data.Triggers = append(data.Triggers, t.Data())
+	}
+	for _, p := range a.ItemPiles() {
+		data.ItemPiles = append(data.ItemPiles, p.Data())
 	}
 	for _, o := range a.Objects() {
 		c, ok := o.(*character.Character)
@@ -316,46 +603,287 @@ func (a *Area) Data() res.AreaData {
 		charData.DefX, charData.DefY = c.DefaultPosition()
 		charData.Respawn = c.Respawn()
 		charData.Despawn = c.Despawn()
+		charData.AI = c.AI()
 		data.Characters = append(data.Characters, charData)
 	}
+	for _, o := range a.Objects() {
+		ob, ok := o.(*object.Object)
+		if !ok {
+			continue
+		}
+		data.Objects = append(data.Objects, ob.Data())
+	}
 	for _, sa := range a.Subareas() {
 		data.Subareas = append(data.Subareas, sa.Data())
 	}
 	return data
 }
 
+// applyObject creates new area object from specified data,
+// or applies data on existing area object.
+// Object data without restore flag is applied on the
+// object base data, if there is any.
+func (a *Area) applyObject(data res.ObjectData) {
+	if baseData := res.Object(data.ID); !data.Restore && baseData != nil {
+		objData := *baseData
+		objData.Serial = data.Serial
+		objData.PosX, objData.PosY = data.PosX, data.PosY
+		if len(data.State) > 0 {
+			objData.State = data.State
+		}
+		data = objData
+	}
+	data.Area = a.ID()
+	ob, _ := serial.Object(data.ID, data.Serial).(*object.Object)
+	if ob != nil {
+		ob.Apply(data)
+		_, inArea := a.objects.Load(data.ID + data.Serial)
+		if !inArea {
+			a.AddObject(ob)
+		}
+		return
+	}
+	a.AddObject(object.New(data))
+}
+
+// addSpawnPoint adds specified spawn point to the area,
+// or replaces spawn point with the same ID.
+func (a *Area) addSpawnPoint(data res.SpawnPointData) {
+	for i, sp := range a.spawnPoints {
+		if sp.ID == data.ID {
+			a.spawnPoints[i] = data
+			return
+		}
+	}
+	a.spawnPoints = append(a.spawnPoints, data)
+}
+
+// applyTrigger creates new area trigger from specified
+// data, or applies data on existing trigger.
+// Trigger data without region keeps the region of the
+// existing trigger, e.g. the one from the area map.
+func (a *Area) applyTrigger(data res.TriggerData) {
+	v, _ := a.triggers.Load(data.ID)
+	t, ok := v.(*Trigger)
+	if !ok {
+		a.AddTrigger(NewTrigger(data))
+		return
+	}
+	if emptyRegion(data.Region) {
+		data.Region = t.Region().Data()
+	}
+	t.Apply(data)
+}
+
+// updateTriggers updates area triggers for specified
+// object.
+func (a *Area) updateTriggers(ob Object, delta int64) {
+	for _, t := range a.Triggers() {
+		inside, changed := t.update(ob)
+		if !changed {
+			if inside && t.stay(ob, delta) && a.onTriggerStay != nil {
+				a.onTriggerStay(t, ob)
+			}
+			continue
+		}
+		if inside && a.onTriggerEnter != nil {
+			a.onTriggerEnter(t, ob)
+		}
+		if !inside && a.onTriggerLeave != nil {
+			a.onTriggerLeave(t, ob)
+		}
+	}
+}
+
+// EnteredPortal returns area portal which region was
+// just entered by specified object, or nil if object
+// did not enter any portal.
+// Objects added to the area inside the portal region
+// need to leave the region first to enter the portal.
+func (a *Area) EnteredPortal(ob Object) *Portal {
+	var entered *Portal
+	for _, p := range a.Portals() {
+		if p.enter(ob) && entered == nil {
+			entered = p
+		}
+	}
+	return entered
+}
+
+// applyPortal creates new area portal from specified
+// data, or applies data on existing portal.
+// Portal data without region keeps the region of the
+// existing portal, e.g. the one from the area map.
+func (a *Area) applyPortal(data res.PortalData) {
+	v, _ := a.portals.Load(data.ID)
+	p, ok := v.(*Portal)
+	if !ok {
+		a.AddPortal(NewPortal(data))
+		return
+	}
+	if emptyRegion(data.Region) {
+		data.Region = p.Region().Data()
+	}
+	p.Apply(data)
+}
+
 // moveObject moves object towards speicifed
-// XY position.
+// XY position, along the path to this position.
 func (a *Area) moveObject(ob Object, x, y float64) {
 	ob.Interrupt()
-	obX, obY := ob.Position()
-	if obX < x && a.passable(obX+1, obY) {
-		obX += 1
+	p := a.objectPath(ob, x, y)
+	if len(p.waypoints) < 1 {
+		a.paths.Delete(ob.ID() + ob.Serial())
+		return
 	}
-	if obX > x && a.passable(obX-1, obY) {
-		obX -= 1
+	wp := p.waypoints[0]
+	obX, obY := ob.Position()
+	stepX := math.Min(1, math.Abs(wp.X-obX))
+	stepY := math.Min(1, math.Abs(wp.Y-obY))
+	if wp.X < obX {
+		stepX = -stepX
 	}
-	if obY < y && a.passable(obX, obY+1) {
-		obY += 1
+	if wp.Y < obY {
+		stepY = -stepY
 	}
-	if obY > y && a.passable(obX, obY-1) {
-		obY -= 1
+	if blocker := a.collision(ob, obX+stepX, obY+stepY); blocker != nil {
+		if a.collides(ob, x, y) {
+			// Destination point is occupied.
+			a.paths.Delete(ob.ID() + ob.Serial())
+			ob.SetDestPoint(obX, obY)
+			return
+		}
+		obX, obY = a.sidestep(ob, blocker, wp)
+	} else {
+		if a.passable(obX+stepX, obY) {
+			obX += stepX
+		}
+		if a.passable(obX, obY+stepY) {
+			obY += stepY
+		}
 	}
+	posX, posY := ob.Position()
 	ob.SetPosition(obX, obY)
-	ob.SetMoveCooldown(ob.BaseMoveCooldown())
+	cooldown := float64(ob.BaseMoveCooldown())
+	if tile, ok := a.Map().PositionTile(obX, obY); ok {
+		cooldown *= tile.Properties().MoveCost
+		a.applyHazard(ob, tile)
+	}
+	ob.SetMoveCooldown(int64(math.Round(cooldown)))
+	switch {
+	case obX == wp.X && obY == wp.Y:
+		p.waypoints = p.waypoints[1:]
+	case obX == posX && obY == posY:
+		// Object is stuck, search for a new path next time.
+		a.paths.Delete(ob.ID() + ob.Serial())
+	}
+}
+
+// sidestep returns position of specified object after
+// stepping around specified blocking object on the way
+// to specified waypoint.
+func (a *Area) sidestep(ob, blocker Object, wp Waypoint) (float64, float64) {
+	x, y := ob.Position()
+	bX, bY := blocker.Position()
+	dist := math.Hypot(x-bX, y-bY)
+	if dist == 0 {
+		return x, y
+	}
+	// Tangents to the blocking object collision circle.
+	sides := [][]float64{
+		{-(y - bY) / dist, (x - bX) / dist},
+		{(y - bY) / dist, -(x - bX) / dist},
+	}
+	if (wp.X-x)*sides[1][0]+(wp.Y-y)*sides[1][1] > (wp.X-x)*sides[0][0]+(wp.Y-y)*sides[0][1] {
+		sides[0], sides[1] = sides[1], sides[0]
+	}
+	for _, s := range sides {
+		if a.free(ob, x+s[0], y+s[1]) {
+			return x + s[0], y + s[1]
+		}
+	}
+	return x, y
+}
+
+// free checks if specified object can move to
+// specified XY position.
+func (a *Area) free(ob Object, x, y float64) bool {
+	return a.passable(x, y) && !a.collides(ob, x, y)
+}
+
+// collides checks if specified object moved to specified
+// XY position would collide with other blocking object.
+func (a *Area) collides(ob Object, x, y float64) bool {
+	return a.collision(ob, x, y) != nil
+}
+
+// collision returns blocking object that would collide with
+// specified object moved to specified XY position, or nil if
+// there is no such object.
+// Objects already overlapping can move away from each other.
+func (a *Area) collision(ob Object, x, y float64) Object {
+	collider, ok := ob.(Collider)
+	if !ok || !collider.Blocking() {
+		return nil
+	}
+	obX, obY := ob.Position()
+	for _, o := range a.Objects() {
+		if o.ID() == ob.ID() && o.Serial() == ob.Serial() {
+			continue
+		}
+		c, ok := o.(Collider)
+		if !ok || !c.Blocking() {
+			continue
+		}
+		minDist := collider.CollisionRadius() + c.CollisionRadius()
+		if minDist <= 0 {
+			continue
+		}
+		oX, oY := o.Position()
+		dist := math.Hypot(oX-x, oY-y)
+		if dist < minDist && dist < math.Hypot(oX-obX, oY-obY) {
+			return o
+		}
+	}
+	return nil
 }
 
 // passable checks if specified XY position is passable
-// i.e. is withing on of passable layers of the area map.
+// i.e. is within passable tile of the area map.
 func (a *Area) passable(x, y float64) bool {
-	layer := a.Map().PositionLayer(x, y)
-	if len(layer.Name()) < 1 {
+	tile, ok := a.Map().PositionTile(x, y)
+	if !ok {
 		return true
 	}
-	for _, l := range PassableMapLayers {
-		if l == layer.Name() {
-			return true
-		}
+	return tile.Properties().Passable
+}
+
+// timeOfDay returns current time of day on the area clock.
+func (a *Area) timeOfDay() time.Time {
+	return time.Time{}.Add(time.Duration(a.Clock().TimeOfDay()) * time.Millisecond)
+}
+
+// outdoor checks if specified object is outdoors.
+func (a *Area) outdoor(ob Object) bool {
+	tile, ok := a.Map().PositionTile(ob.Position())
+	return !ok || !tile.Properties().Indoor
+}
+
+// applyHazard applies hazard effect of specified map tile
+// on specified object, if object is not already affected
+// by this effect.
+func (a *Area) applyHazard(ob Object, tile Tile) {
+	hazard := tile.Properties().Hazard
+	if len(hazard) < 1 {
+		return
+	}
+	if hasEffect(ob, hazard) {
+		return
+	}
+	data := res.Effect(hazard)
+	if data == nil {
+		log.Err.Printf("area: %s: hazard effect not found: %s", a.ID(), hazard)
+		return
 	}
-	return false
+	ob.TakeEffect(effect.New(*data))
 }
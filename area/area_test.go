@@ -1,7 +1,7 @@
 /*
  * area.go
  *
- * Copyright 2022-2024 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2022-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -24,10 +24,17 @@
 package area
 
 import (
+	"os"
 	"testing"
 
+	"github.com/isangeles/tmx"
+
 	"github.com/isangeles/flame/character"
 	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/flag"
+	"github.com/isangeles/flame/object"
+	"github.com/isangeles/flame/serial"
 )
 
 var (
@@ -129,6 +136,238 @@ func TestCharacterMove(t *testing.T) {
 	}
 }
 
+// TestMoveObjectTileProperties tests moving objects
+// on map tiles with movement cost and hazard effect.
+func TestMoveObjectTileProperties(t *testing.T) {
+	// Create area & object
+	file, err := os.Open("testres/props.tmx")
+	if err != nil {
+		t.Fatalf("Unable to open map file: %v", err)
+	}
+	defer file.Close()
+	mapData, err := tmx.Read(file)
+	if err != nil {
+		t.Fatalf("Unable to read map file: %v", err)
+	}
+	area := New(res.AreaData{ID: "area", Map: mapData})
+	effects := res.Effects
+	t.Cleanup(func() { res.Effects = effects })
+	res.Effects = append(res.Effects, res.EffectData{ID: "swampEffect", Duration: 1000})
+	ob := character.New(charData)
+	x, y := area.Map().tileCenter(2, 0)
+	ob.SetPosition(x, y)
+	area.AddObject(ob)
+	// Test
+	ob.SetDestPoint(x+2, y)
+	area.Update(1)
+	cooldown := ob.BaseMoveCooldown() * 2
+	if ob.MoveCooldown() != cooldown {
+		t.Errorf("Invalid move cooldown: %d != %d", ob.MoveCooldown(), cooldown)
+	}
+	area.Update(ob.MoveCooldown())
+	hazards := 0
+	for _, e := range ob.Effects() {
+		if e.ID() == "swampEffect" {
+			hazards++
+		}
+	}
+	if hazards != 1 {
+		t.Errorf("Invalid number of hazard effects: %d != 1", hazards)
+	}
+	x, y = area.Map().tileCenter(3, 0)
+	if area.passable(x, y) {
+		t.Errorf("Position should not be passable: %f %f", x, y)
+	}
+}
+
+// TestApplyMapObjects tests applying area data with
+// objects from the area map.
+func TestApplyMapObjects(t *testing.T) {
+	// Create area
+	mapData, err := testObjectsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	res.Characters = append(res.Characters, res.CharacterData{ID: "npc", Level: 1})
+	area := New(res.AreaData{ID: "area", Map: mapData})
+	// Test
+	if len(area.Objects()) != 1 {
+		t.Fatalf("Invalid number of area objects: %d != 1", len(area.Objects()))
+	}
+	x, y := area.Objects()[0].Position()
+	if x != 64 || y != 256 {
+		t.Errorf("Invalid object position: %f %f != 64 256", x, y)
+	}
+	if _, _, ok := area.SpawnPoint("start"); !ok {
+		t.Errorf("Spawn point not found")
+	}
+	if len(area.Portals()) != 1 {
+		t.Errorf("Invalid number of portals: %d != 1", len(area.Portals()))
+	}
+	if len(area.Triggers()) != 1 {
+		t.Errorf("Invalid number of triggers: %d != 1", len(area.Triggers()))
+	}
+	data := area.Data()
+	data.Characters = nil
+	area = New(data)
+	if len(area.Objects()) != 0 {
+		t.Errorf("Map objects added to restored area: %d", len(area.Objects()))
+	}
+	if len(area.Portals()) != 1 {
+		t.Errorf("Invalid number of portals: %d != 1", len(area.Portals()))
+	}
+}
+
+// TestAreaTrigger tests entering and leaving area
+// trigger regions.
+func TestAreaTrigger(t *testing.T) {
+	// Create area & object
+	mapData, err := testObjectsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	area := New(res.AreaData{ID: "area", Restore: true, Map: mapData})
+	ob := character.New(charData)
+	ob.SetPosition(32, 128)
+	area.AddObject(ob)
+	entered, left := 0, 0
+	area.SetOnTriggerEnterFunc(func(t *Trigger, o Object) { entered++ })
+	area.SetOnTriggerLeaveFunc(func(t *Trigger, o Object) { left++ })
+	// Test
+	area.Update(1)
+	area.Update(1)
+	if entered != 1 {
+		t.Errorf("Invalid number of trigger enters: %d != 1", entered)
+	}
+	ob.SetPosition(128, 128)
+	area.Update(1)
+	if left != 1 {
+		t.Errorf("Invalid number of trigger leaves: %d != 1", left)
+	}
+}
+
+// TestAreaPortal tests entering the area portal.
+func TestAreaPortal(t *testing.T) {
+	// Create area & object
+	mapData, err := testObjectsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	area := New(res.AreaData{ID: "area", Restore: true, Map: mapData})
+	ob := character.New(charData)
+	ob.SetPosition(280, 300)
+	area.AddObject(ob)
+	// Test
+	if area.EnteredPortal(ob) != nil {
+		t.Errorf("Object added inside the portal region entered portal")
+	}
+	ob.SetPosition(200, 200)
+	if area.EnteredPortal(ob) != nil {
+		t.Errorf("Object outside the portal region entered portal")
+	}
+	ob.SetPosition(280, 300)
+	portal := area.EnteredPortal(ob)
+	if portal == nil {
+		t.Fatalf("Object did not enter the portal")
+	}
+	if portal.DestArea() != "area2" {
+		t.Errorf("Invalid portal destination area: %s != area2", portal.DestArea())
+	}
+	if area.EnteredPortal(ob) != nil {
+		t.Errorf("Object entered the portal twice")
+	}
+}
+
+// TestAreaPortalRequirements tests entering the area portal
+// with requirements.
+func TestAreaPortalRequirements(t *testing.T) {
+	// Create area & object
+	portalData := res.PortalData{
+		ID:           "portal",
+		Region:       res.RegionData{Width: 10, Height: 10},
+		Requirements: res.ReqsData{FlagReqs: []res.IDReqData{{ID: "flagKey"}}},
+	}
+	area := New(res.AreaData{ID: "area", Portals: []res.PortalData{portalData}})
+	ob := character.New(charData)
+	ob.SetPosition(20, 20)
+	area.AddObject(ob)
+	// Test
+	ob.SetPosition(5, 5)
+	if area.EnteredPortal(ob) != nil {
+		t.Errorf("Object entered the portal without meeting requirements")
+	}
+	ob.AddFlag(flag.Flag("flagKey"))
+	if area.EnteredPortal(ob) == nil {
+		t.Errorf("Object did not enter the portal")
+	}
+}
+
+// TestAreaPortalLock tests entering the locked area portal.
+func TestAreaPortalLock(t *testing.T) {
+	// Create area & object
+	portalData := res.PortalData{
+		ID:     "portal",
+		Region: res.RegionData{Width: 10, Height: 10},
+		Lock:   res.LockData{Locked: true, Flag: "flagKey", Relock: 10},
+	}
+	area := New(res.AreaData{ID: "area", Portals: []res.PortalData{portalData}})
+	ob := character.New(charData)
+	ob.SetPosition(20, 20)
+	area.AddObject(ob)
+	// Test
+	ob.SetPosition(5, 5)
+	if area.EnteredPortal(ob) != nil {
+		t.Errorf("Object entered the locked portal")
+	}
+	ob.AddFlag(flag.Flag("flagKey"))
+	portal := area.Portals()[0]
+	if err := portal.Lock().Unlock(ob); err != nil {
+		t.Fatalf("Unable to unlock portal: %v", err)
+	}
+	if area.EnteredPortal(ob) == nil {
+		t.Errorf("Object did not enter the unlocked portal")
+	}
+	area.Update(10)
+	if !portal.Locked() {
+		t.Errorf("Portal not relocked")
+	}
+}
+
+// TestAreaObjects tests applying and restoring area objects.
+func TestAreaObjects(t *testing.T) {
+	// Create area
+	res.Add(res.ResourcesData{Objects: []res.ObjectData{{
+		ID:     "chest",
+		State:  "closed",
+		States: []res.ObjectStateData{{ID: "closed"}, {ID: "open"}},
+	}}})
+	objData := res.ObjectData{ID: "chest", Serial: "0", State: "open", PosX: 10, PosY: 10}
+	area := New(res.AreaData{ID: "area", Objects: []res.ObjectData{objData}})
+	// Test
+	ob, ok := serial.Object("chest", "0").(*object.Object)
+	if !ok || !containsObject("chest", "0", area.Objects()...) {
+		t.Fatalf("Object not added to the area")
+	}
+	if len(ob.States()) != 2 {
+		t.Errorf("Base object data not applied")
+	}
+	ob.SetState("closed")
+	data := area.Data()
+	if len(data.Objects) != 1 {
+		t.Fatalf("Invalid number of objects data: %d != 1", len(data.Objects))
+	}
+	if data.Objects[0].State != "closed" || !data.Objects[0].Restore {
+		t.Errorf("Invalid object data: %v", data.Objects[0])
+	}
+	area.Apply(data)
+	if !containsObject("chest", "0", area.Objects()...) {
+		t.Errorf("Object removed from the area")
+	}
+	if ob.State() != "closed" {
+		t.Errorf("Invalid object state: %s != closed", ob.State())
+	}
+}
+
 // containsObject checks if object with specified ID and serial
 func containsObject(id, serial string, obs ...Object) bool {
 	for _, ob := range obs {
@@ -138,3 +377,72 @@ func containsObject(id, serial string, obs ...Object) bool {
 	}
 	return false
 }
+
+// TestCallForHelp tests alerting character allies
+// about attackers.
+func TestCallForHelp(t *testing.T) {
+	// Create test objects
+	attacker := character.New(charData)
+	victim := character.New(charData)
+	victim.SetGuild(character.NewGuild("guards"))
+	victim.SetHelpRange(50)
+	ally := character.New(charData)
+	ally.SetGuild(character.NewGuild("guards"))
+	ally.SetPosition(10, 10)
+	farAlly := character.New(charData)
+	farAlly.SetGuild(character.NewGuild("guards"))
+	farAlly.SetPosition(100, 100)
+	stranger := character.New(charData)
+	stranger.SetPosition(10, 0)
+	area := New(areaData)
+	for _, c := range []*character.Character{attacker, victim, ally, farAlly, stranger} {
+		c.SetHealth(c.MaxHealth())
+		area.AddObject(c)
+	}
+	hit := effect.New(res.EffectData{ID: "hit", Hostile: true})
+	hit.SetSource(attacker.ID(), attacker.Serial())
+	// Test
+	victim.TakeEffect(hit)
+	area.Update(1)
+	if ally.AttitudeFor(attacker) != character.Hostile {
+		t.Errorf("Invalid ally attitude for attacker: %v != %v",
+			ally.AttitudeFor(attacker), character.Hostile)
+	}
+	if farAlly.AttitudeFor(attacker) == character.Hostile {
+		t.Errorf("Ally outside help range alerted")
+	}
+	if stranger.AttitudeFor(attacker) == character.Hostile {
+		t.Errorf("Not allied character alerted")
+	}
+	if len(victim.HelpCalls()) > 0 {
+		t.Errorf("Help calls not cleared: %d", len(victim.HelpCalls()))
+	}
+}
+
+// TestUpdateSummons tests adding and removing
+// summoned characters.
+func TestUpdateSummons(t *testing.T) {
+	// Create test objects
+	res.Characters = append(res.Characters, res.CharacterData{ID: "summon", Level: 1})
+	owner := character.New(charData)
+	owner.SetHealth(owner.MaxHealth())
+	area := New(areaData)
+	area.AddObject(owner)
+	summonMod := effect.NewModifiers(res.ModifiersData{
+		SummonMods: []res.SummonModData{{ID: "summon", Time: 10}},
+	})
+	// Test
+	owner.TakeModifiers(nil, summonMod...)
+	area.Update(1)
+	if len(area.Objects()) != 2 || len(owner.SummonQueue()) > 0 {
+		t.Fatalf("Summon not added to area: %d", len(area.Objects()))
+	}
+	summon := owner.Summons()[0]
+	area.Update(10)
+	if len(area.Objects()) != 1 {
+		t.Errorf("Expired summon not removed from area: %d", len(area.Objects()))
+	}
+	if serial.Object(summon.ID(), summon.Serial()) != nil {
+		t.Errorf("Expired summon still registered")
+	}
+}
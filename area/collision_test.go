@@ -0,0 +1,117 @@
+/*
+ * collision_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"math"
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+)
+
+// TestMoveObjectCollision tests moving object around
+// other blocking object.
+func TestMoveObjectCollision(t *testing.T) {
+	// Create area & objects
+	area := New(areaData)
+	data := res.CharacterData{ID: "char", Level: 1, Radius: 5}
+	ob := character.New(data)
+	area.AddObject(ob)
+	blocker := character.New(data)
+	blocker.SetPosition(20, 0)
+	area.AddObject(blocker)
+	// Test
+	ob.SetDestPoint(40, 0)
+	for i := 0; i < 2000; i++ {
+		area.Update(ob.BaseMoveCooldown())
+		x, y := ob.Position()
+		if dist := math.Hypot(20-x, -y); dist < 10 {
+			t.Fatalf("Object collided with blocking object: %f %f", x, y)
+		}
+		if x == 40 && y == 0 {
+			break
+		}
+	}
+	x, y := ob.Position()
+	if x != 40 || y != 0 {
+		t.Errorf("Object not moved to the destination point: %f %f", x, y)
+	}
+}
+
+// TestMoveObjectNonBlocking tests moving object through
+// non-blocking object.
+func TestMoveObjectNonBlocking(t *testing.T) {
+	// Create area & objects
+	area := New(areaData)
+	data := res.CharacterData{ID: "char", Level: 1, Radius: 5}
+	ob := character.New(data)
+	area.AddObject(ob)
+	data.NonBlocking = true
+	ghost := character.New(data)
+	ghost.SetPosition(20, 0)
+	area.AddObject(ghost)
+	// Test
+	ob.SetDestPoint(40, 0)
+	for i := 0; i < 2000; i++ {
+		area.Update(ob.BaseMoveCooldown())
+		x, y := ob.Position()
+		if y != 0 {
+			t.Fatalf("Object moved around non-blocking object: %f %f", x, y)
+		}
+		if x == 40 {
+			break
+		}
+	}
+	x, y := ob.Position()
+	if x != 40 || y != 0 {
+		t.Errorf("Object not moved to the destination point: %f %f", x, y)
+	}
+}
+
+// TestMoveObjectOccupiedDest tests moving object to
+// destination point occupied by other blocking object.
+func TestMoveObjectOccupiedDest(t *testing.T) {
+	// Create area & objects
+	area := New(areaData)
+	data := res.CharacterData{ID: "char", Level: 1, Radius: 5}
+	ob := character.New(data)
+	area.AddObject(ob)
+	blocker := character.New(data)
+	blocker.SetPosition(20, 0)
+	area.AddObject(blocker)
+	// Test
+	ob.SetDestPoint(20, 0)
+	for i := 0; i < 2000 && (ob.Moving() || ob.MoveCooldown() > 0); i++ {
+		area.Update(ob.BaseMoveCooldown())
+	}
+	x, y := ob.Position()
+	if x != 10 || y != 0 {
+		t.Errorf("Invalid object position: %f %f != 10 0", x, y)
+	}
+	destX, destY := ob.DestPoint()
+	if destX != x || destY != y {
+		t.Errorf("Object destination point not reset: %f %f", destX, destY)
+	}
+}
@@ -1,7 +1,7 @@
 /*
  * map.go
  *
- * Copyright 2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2023-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -24,34 +24,94 @@
 package area
 
 import (
+	"math"
+	"strconv"
+	"strings"
+
 	"github.com/isangeles/tmx"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+const (
+	passableProperty       = "passable"
+	passableLayersProperty = "passable-layers"
+	moveCostProperty       = "move-cost"
+	blocksSightProperty    = "blocks-sight"
+	hazardProperty         = "hazard"
+	indoorProperty         = "indoor"
+	minTileMoveCost        = 0.1
 )
 
 // Struct for area map.
 type Map struct {
-	width, height int
-	layers        []Layer
-	data          *tmx.Map
+	width, height         int
+	tileWidth, tileHeight int
+	columns, rows         int
+	minMoveCost           float64
+	layers                []Layer
+	grid                  []*Tile
+	characters            []res.AreaCharData
+	spawnPoints           []res.SpawnPointData
+	portals               []res.PortalData
+	triggers              []res.TriggerData
+	objects               []res.ObjectData
+	data                  *tmx.Map
 }
 
 // Struct for area map layer.
 type Layer struct {
-	name  string
-	tiles []Tile
+	name       string
+	tiles      []Tile
+	properties TileProperties
 }
 
 // Struct for map tile.
 type Tile struct {
 	x, y, endX, endY float64
+	column, row      int
+	properties       TileProperties
+}
+
+// Struct for properties of map tiles.
+type TileProperties struct {
+	Passable    bool
+	MoveCost    float64
+	BlocksSight bool
+	Hazard      string
+	Indoor      bool
 }
 
 // newMap creates new area map.
+// Tiles properties are set from properties of the TMX layers,
+// and can be overwritten by properties of the TMX tilesets.
+// Area characters, objects, spawn points, portals and triggers are
+// created from the TMX objects, depending on the object type.
 func newMap(data *tmx.Map) Map {
-	m := Map{data: data}
+	m := Map{data: data, minMoveCost: 1}
 	m.width = data.TileWidth * data.Width
 	m.height = data.TileHeight * data.Height
+	m.tileWidth, m.tileHeight = data.TileWidth, data.TileHeight
+	m.columns, m.rows = data.Width, data.Height
+	var passableLayers []string
+	for _, p := range data.Properties {
+		if p.Name == passableLayersProperty {
+			passableLayers = strings.Split(p.Value, ",")
+		}
+	}
+	if len(passableLayers) < 1 {
+		passableLayers = PassableMapLayers
+	}
 	for _, tmxLayer := range data.Layers {
 		layer := Layer{name: tmxLayer.Name}
+		layer.properties.MoveCost = 1
+		for _, l := range passableLayers {
+			if strings.TrimSpace(l) == layer.Name() {
+				layer.properties.Passable = true
+			}
+		}
+		layer.properties.apply(tmxLayer.Properties)
 		var tileX, tileY int
 		for _, dt := range tmxLayer.DecodedTiles {
 			if dt.Tileset != nil {
@@ -59,7 +119,9 @@ func newMap(data *tmx.Map) Map {
 				tilePosY := float64(int(data.TileHeight) * tileY)
 				tilePosY = float64(m.height) - tilePosY
 				tile := Tile{tilePosX, tilePosY, tilePosX + float64(data.TileWidth),
-					tilePosY + float64(data.TileHeight)}
+					tilePosY + float64(data.TileHeight), tileX, tileY, layer.properties}
+				tile.properties.apply(dt.Tileset.Properties)
+				m.minMoveCost = math.Min(m.minMoveCost, tile.properties.MoveCost)
 				layer.tiles = append(layer.tiles, tile)
 			}
 			tileX++
@@ -70,6 +132,14 @@ func newMap(data *tmx.Map) Map {
 		}
 		m.layers = append(m.layers, layer)
 	}
+	m.grid = make([]*Tile, m.columns*m.rows)
+	for i := range m.layers {
+		for j := range m.layers[i].tiles {
+			t := &m.layers[i].tiles[j]
+			m.grid[t.row*m.columns+t.column] = t
+		}
+	}
+	m.parseObjects(data.ObjectGroups)
 	return m
 }
 
@@ -101,6 +171,55 @@ func (m Map) Data() *tmx.Map {
 	return m.data
 }
 
+// TileSize returns size of the map tile.
+func (m Map) TileSize() (int, int) {
+	return m.tileWidth, m.tileHeight
+}
+
+// tilePosition returns column and row of the map tile
+// on specified XY position.
+// Returns false if position is outside the map tiles grid.
+func (m Map) tilePosition(x, y float64) (int, int, bool) {
+	if m.tileWidth < 1 || m.tileHeight < 1 {
+		return 0, 0, false
+	}
+	column := int(math.Floor(x / float64(m.tileWidth)))
+	row := int(math.Ceil((float64(m.height) - y) / float64(m.tileHeight)))
+	if column < 0 || row < 0 || column >= m.columns || row >= m.rows {
+		return 0, 0, false
+	}
+	return column, row, true
+}
+
+// tileCenter returns XY position of the center of the map
+// tile with specified column and row.
+func (m Map) tileCenter(column, row int) (float64, float64) {
+	x := float64(column*m.tileWidth) + float64(m.tileWidth)/2
+	y := float64(m.height-row*m.tileHeight) + float64(m.tileHeight)/2
+	return x, y
+}
+
+// PositionTile returns visible tile on specified XY
+// position on the map.
+// Returns false if there is no tile on specified position.
+func (m Map) PositionTile(x, y float64) (Tile, bool) {
+	column, row, ok := m.tilePosition(x, y)
+	if !ok {
+		return Tile{}, false
+	}
+	return m.tile(column, row)
+}
+
+// tile returns visible tile with specified column and row.
+// Returns false if there is no tile on specified column and row.
+func (m Map) tile(column, row int) (Tile, bool) {
+	t := m.grid[row*m.columns+column]
+	if t == nil {
+		return Tile{}, false
+	}
+	return *t, true
+}
+
 // Name returns layer name.
 func (l Layer) Name() string {
 	return l.name
@@ -111,8 +230,49 @@ func (l Layer) Tiles() []Tile {
 	return l.tiles
 }
 
+// Properties returns default properties for the
+// layer tiles.
+func (l Layer) Properties() TileProperties {
+	return l.properties
+}
+
 // Constains checks if specified XY posistion is contained
 // inside the map tile.
 func (t Tile) Contains(x, y float64) bool {
 	return x >= t.x && y >= t.y && x <= t.endX && y <= t.endY
 }
+
+// Properties returns tile properties.
+func (t Tile) Properties() TileProperties {
+	return t.properties
+}
+
+// apply applies specified TMX properties on the
+// tile properties.
+func (tp *TileProperties) apply(props []tmx.Property) {
+	var err error
+	for _, p := range props {
+		switch p.Name {
+		case passableProperty:
+			tp.Passable, err = strconv.ParseBool(p.Value)
+		case moveCostProperty:
+			tp.MoveCost, err = strconv.ParseFloat(p.Value, 64)
+			if err == nil && tp.MoveCost < minTileMoveCost {
+				log.Err.Printf("area map: invalid move cost: %f, using minimal cost: %f",
+					tp.MoveCost, minTileMoveCost)
+				tp.MoveCost = minTileMoveCost
+			}
+		case blocksSightProperty:
+			tp.BlocksSight, err = strconv.ParseBool(p.Value)
+		case hazardProperty:
+			tp.Hazard = p.Value
+		case indoorProperty:
+			tp.Indoor, err = strconv.ParseBool(p.Value)
+		}
+		if err != nil {
+			log.Err.Printf("area map: unable to parse property: %s: %v",
+				p.Name, err)
+			err = nil
+		}
+	}
+}
@@ -1,7 +1,7 @@
 /*
  * map_test.go
  *
- * Copyright 2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2023-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -79,6 +79,144 @@ func TestMapLayers(t *testing.T) {
 	}
 }
 
+// TestMapTileProperties tests setting tiles properties
+// from the map layers and tilesets properties.
+func TestMapTileProperties(t *testing.T) {
+	// Create map
+	data, err := testPropsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	m := newMap(data)
+	// Test
+	tests := []struct {
+		column, row int
+		props       TileProperties
+	}{
+		{0, 0, TileProperties{true, 1, false, "", false}},
+		{1, 0, TileProperties{true, 0.5, false, "", true}},
+		{2, 0, TileProperties{true, 2, false, "swampEffect", false}},
+		{3, 0, TileProperties{false, 1, false, "", false}},
+		{4, 0, TileProperties{false, 1, true, "", false}},
+		{5, 0, TileProperties{false, 1, false, "", false}},
+		{0, 1, TileProperties{true, 3, false, "", false}},
+	}
+	for _, test := range tests {
+		tile, ok := m.tile(test.column, test.row)
+		if !ok {
+			t.Errorf("Tile not found: %d %d", test.column, test.row)
+			continue
+		}
+		if tile.Properties() != test.props {
+			t.Errorf("Invalid tile properties: %d %d: %v != %v", test.column,
+				test.row, tile.Properties(), test.props)
+		}
+	}
+	if m.minMoveCost != 0.5 {
+		t.Errorf("Invalid min move cost: %f != 0.5", m.minMoveCost)
+	}
+}
+
+// TestMapMoveCost tests clamping invalid move costs
+// of map tiles.
+func TestMapMoveCost(t *testing.T) {
+	// Test
+	tests := []struct {
+		value string
+		cost  float64
+	}{
+		{"0", minTileMoveCost},
+		{"-2", minTileMoveCost},
+		{"0.5", 0.5},
+	}
+	for _, test := range tests {
+		props := TileProperties{MoveCost: 1}
+		props.apply([]tmx.Property{{moveCostProperty, test.value}})
+		if props.MoveCost != test.cost {
+			t.Errorf("Invalid move cost: %s: %f != %f", test.value, props.MoveCost,
+				test.cost)
+		}
+	}
+}
+
+// TestMapPositionTile tests retrieving map tiles by
+// XY position.
+func TestMapPositionTile(t *testing.T) {
+	// Create map
+	data, err := testPropsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	m := newMap(data)
+	// Test
+	x, y := m.tileCenter(3, 0)
+	tile, ok := m.PositionTile(x, y)
+	if !ok {
+		t.Fatalf("Tile not found: %f %f", x, y)
+	}
+	if tile.Properties().Passable {
+		t.Errorf("Tile should not be passable: %f %f", x, y)
+	}
+	_, ok = m.PositionTile(-10, y)
+	if ok {
+		t.Errorf("Tile found outside the map: %f %f", -10.0, y)
+	}
+}
+
+// TestMapObjects tests creating area resources from
+// the TMX map objects.
+func TestMapObjects(t *testing.T) {
+	// Create map
+	data, err := testObjectsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	m := newMap(data)
+	// Test
+	if len(m.characters) != 1 {
+		t.Fatalf("Invalid number of characters: %d != 1", len(m.characters))
+	}
+	char := m.characters[0]
+	if char.ID != "npc" {
+		t.Errorf("Invalid character ID: %s != npc", char.ID)
+	}
+	if char.InitX != 64 || char.InitY != 256 {
+		t.Errorf("Invalid character position: %f %f != 64 256", char.InitX,
+			char.InitY)
+	}
+	if char.Respawn != 1000 {
+		t.Errorf("Invalid character respawn: %d != 1000", char.Respawn)
+	}
+	if len(char.Flags) != 2 {
+		t.Errorf("Invalid number of character flags: %d != 2", len(char.Flags))
+	}
+	if len(m.spawnPoints) != 1 {
+		t.Fatalf("Invalid number of spawn points: %d != 1", len(m.spawnPoints))
+	}
+	if m.spawnPoints[0].X != 32 || m.spawnPoints[0].Y != 320 {
+		t.Errorf("Invalid spawn point position: %f %f != 32 320",
+			m.spawnPoints[0].X, m.spawnPoints[0].Y)
+	}
+	if len(m.portals) != 1 {
+		t.Fatalf("Invalid number of portals: %d != 1", len(m.portals))
+	}
+	portal := m.portals[0]
+	if portal.ID != "exit" || portal.DestArea != "area2" {
+		t.Errorf("Invalid portal: %s %s != exit area2", portal.ID, portal.DestArea)
+	}
+	if portal.Region.X != 256 || portal.Region.Y != 288 {
+		t.Errorf("Invalid portal region position: %f %f != 256 288",
+			portal.Region.X, portal.Region.Y)
+	}
+	if len(m.triggers) != 1 {
+		t.Fatalf("Invalid number of triggers: %d != 1", len(m.triggers))
+	}
+	if len(m.triggers[0].Region.Points) != 4 {
+		t.Errorf("Invalid number of trigger region points: %d != 4",
+			len(m.triggers[0].Region.Points))
+	}
+}
+
 // testMap returns test map data.
 func testMap() (*tmx.Map, error) {
 	file, err := os.Open("testres/map.tmx")
@@ -91,3 +229,32 @@ func testMap() (*tmx.Map, error) {
 	}
 	return data, nil
 }
+
+// testPropsMap returns test map data with tiles
+// properties.
+func testPropsMap() (*tmx.Map, error) {
+	file, err := os.Open("testres/props.tmx")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open file: %v", err)
+	}
+	defer file.Close()
+	data, err := tmx.Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read map file: %v", err)
+	}
+	return data, nil
+}
+
+// testObjectsMap returns test map data with objects.
+func testObjectsMap() (*tmx.Map, error) {
+	file, err := os.Open("testres/objects.tmx")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open file: %v", err)
+	}
+	defer file.Close()
+	data, err := tmx.Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read map file: %v", err)
+	}
+	return data, nil
+}
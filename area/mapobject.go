@@ -0,0 +1,171 @@
+/*
+ * mapobject.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/isangeles/tmx"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// Types of the TMX map objects.
+const (
+	characterObjectType = "character"
+	npcObjectType       = "npc"
+	spawnObjectType     = "spawn"
+	portalObjectType    = "portal"
+	triggerObjectType   = "trigger"
+	objectObjectType    = "object"
+)
+
+// parseObjects creates area resources from objects in
+// specified TMX object groups.
+func (m *Map) parseObjects(groups []tmx.ObjectGroup) {
+	for _, g := range groups {
+		for i, ob := range g.Objects {
+			id := ob.Name
+			if len(id) < 1 {
+				id = fmt.Sprintf("%s_%s%d", g.Name, ob.Type, i)
+			}
+			props := make(map[string]string)
+			for _, p := range ob.Properties {
+				props[p.Name] = p.Value
+			}
+			switch ob.Type {
+			case characterObjectType, npcObjectType:
+				m.characters = append(m.characters, m.characterData(ob, props))
+			case spawnObjectType:
+				x, y := m.mapPosition(ob.X, ob.Y)
+				m.spawnPoints = append(m.spawnPoints, res.SpawnPointData{id, x, y})
+			case portalObjectType:
+				portal := res.PortalData{
+					ID:       id,
+					DestArea: props["area"],
+					DestX:    parseFloatProperty(props, "x"),
+					DestY:    parseFloatProperty(props, "y"),
+					Region:   m.regionData(ob),
+				}
+				m.portals = append(m.portals, portal)
+			case triggerObjectType:
+				trigger := res.TriggerData{
+					ID:           id,
+					Once:         props["once"] == "true",
+					StayInterval: int64(parseFloatProperty(props, "stay-interval")),
+					Region:       m.regionData(ob),
+				}
+				m.triggers = append(m.triggers, trigger)
+			case objectObjectType:
+				object := res.ObjectData{
+					ID:     props["id"],
+					Serial: props["serial"],
+					State:  props["state"],
+				}
+				if len(object.ID) < 1 {
+					object.ID = ob.Name
+				}
+				object.PosX, object.PosY = m.mapPosition(ob.X, ob.Y)
+				m.objects = append(m.objects, object)
+			}
+		}
+	}
+}
+
+// characterData creates area character data from specified
+// TMX object and object properties.
+func (m *Map) characterData(ob tmx.Object, props map[string]string) res.AreaCharData {
+	data := res.AreaCharData{
+		ID:     props["id"],
+		Serial: props["serial"],
+	}
+	if len(data.ID) < 1 {
+		data.ID = ob.Name
+	}
+	data.InitX, data.InitY = m.mapPosition(ob.X, ob.Y)
+	data.Respawn = int64(parseFloatProperty(props, "respawn"))
+	data.Despawn = int64(parseFloatProperty(props, "despawn"))
+	data.AI = props["ai"] == "true"
+	for _, f := range strings.Split(props["flags"], ",") {
+		f = strings.TrimSpace(f)
+		if len(f) > 0 {
+			data.Flags = append(data.Flags, res.FlagData{f})
+		}
+	}
+	return data
+}
+
+// regionData creates region data for specified TMX object.
+func (m *Map) regionData(ob tmx.Object) res.RegionData {
+	data := res.RegionData{Width: ob.Width, Height: ob.Height}
+	data.X, data.Y = m.mapPosition(ob.X, ob.Y+ob.Height)
+	if len(ob.Polygons) < 1 {
+		return data
+	}
+	for _, p := range strings.Fields(ob.Polygons[0].Points) {
+		coords := strings.Split(p, ",")
+		if len(coords) != 2 {
+			log.Err.Printf("area map: object: %s: invalid polygon point: %s",
+				ob.Name, p)
+			continue
+		}
+		x, errX := strconv.ParseFloat(coords[0], 64)
+		y, errY := strconv.ParseFloat(coords[1], 64)
+		if errX != nil || errY != nil {
+			log.Err.Printf("area map: object: %s: invalid polygon point: %s",
+				ob.Name, p)
+			continue
+		}
+		point := res.PointData{}
+		point.X, point.Y = m.mapPosition(ob.X+x, ob.Y+y)
+		data.Points = append(data.Points, point)
+	}
+	return data
+}
+
+// mapPosition converts specified TMX position to the
+// position on the area map.
+func (m *Map) mapPosition(x, y float64) (float64, float64) {
+	return x, float64(m.height+m.tileHeight) - y
+}
+
+// parseFloatProperty parses value of the object property
+// with specified name.
+// Returns 0 if property was not found or parsing failed.
+func parseFloatProperty(props map[string]string, name string) float64 {
+	value, ok := props[name]
+	if !ok {
+		return 0
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Err.Printf("area map: unable to parse property: %s: %v",
+			name, err)
+		return 0
+	}
+	return v
+}
@@ -0,0 +1,245 @@
+/*
+ * path.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"container/heap"
+	"math"
+)
+
+// Struct for path waypoint.
+type Waypoint struct {
+	X, Y float64
+}
+
+// Struct for path followed by moving object.
+type path struct {
+	destX, destY float64
+	waypoints    []Waypoint
+}
+
+// Struct for node of the path search.
+type pathNode struct {
+	tile   int
+	parent int
+	cost   float64
+	score  float64
+	index  int
+	closed bool
+}
+
+// Type for queue of path search nodes.
+type pathQueue []*pathNode
+
+// FindPath searches for the path between specified XY positions
+// and returns list of waypoints to reach the destination point.
+// Path is searched on the map tiles grid, between passable
+// tiles, with respect to the tiles movement costs.
+// If destination point is unreachable then the path leads to the
+// reachable tile closest to the destination and false is returned.
+func (a *Area) FindPath(x, y, destX, destY float64) ([]Waypoint, bool) {
+	dest := Waypoint{destX, destY}
+	startColumn, startRow, startOk := a.Map().tilePosition(x, y)
+	destColumn, destRow, destOk := a.Map().tilePosition(destX, destY)
+	if !startOk || !destOk {
+		return []Waypoint{dest}, true
+	}
+	if startColumn == destColumn && startRow == destRow {
+		return []Waypoint{dest}, true
+	}
+	columns := a.Map().columns
+	start := startRow*columns + startColumn
+	goal := destRow*columns + destColumn
+	nodes := make(map[int]*pathNode)
+	startNode := &pathNode{tile: start, parent: -1}
+	minCost := a.Map().minMoveCost
+	startNode.score = tileDistance(start, goal, columns) * minCost
+	nodes[start] = startNode
+	queue := pathQueue{startNode}
+	closest := startNode
+	for len(queue) > 0 {
+		node := heap.Pop(&queue).(*pathNode)
+		if node.tile == goal {
+			closest = node
+			break
+		}
+		node.closed = true
+		if node.score-node.cost < closest.score-closest.cost {
+			closest = node
+		}
+		for _, next := range a.tileNeighbours(node.tile) {
+			cost := node.cost + tileDistance(node.tile, next, columns)*a.tileMoveCost(next)
+			nextNode := nodes[next]
+			if nextNode == nil {
+				nextNode = &pathNode{tile: next, parent: node.tile, cost: cost}
+				nextNode.score = cost + tileDistance(next, goal, columns)*minCost
+				nodes[next] = nextNode
+				heap.Push(&queue, nextNode)
+				continue
+			}
+			if nextNode.closed || cost >= nextNode.cost {
+				continue
+			}
+			nextNode.parent = node.tile
+			nextNode.score += cost - nextNode.cost
+			nextNode.cost = cost
+			heap.Fix(&queue, nextNode.index)
+		}
+	}
+	// Build path from the tiles.
+	var waypoints []Waypoint
+	for n := closest; n.parent > -1; n = nodes[n.parent] {
+		x, y := a.Map().tileCenter(n.tile%columns, n.tile/columns)
+		waypoints = append([]Waypoint{{x, y}}, waypoints...)
+	}
+	if closest.tile != goal {
+		return waypoints, false
+	}
+	waypoints[len(waypoints)-1] = dest
+	return waypoints, true
+}
+
+// ObjectPath returns remaining waypoints of the path
+// currently followed by specified object.
+func (a *Area) ObjectPath(ob Object) []Waypoint {
+	v, _ := a.paths.Load(ob.ID() + ob.Serial())
+	p, ok := v.(*path)
+	if !ok {
+		return nil
+	}
+	return p.waypoints
+}
+
+// objectPath returns path for specified object to the
+// specified destination point.
+// Returns cached path if object already follows the path
+// to this destination point, otherwise searches for a new path.
+// In case of unreachable destination point, the object
+// destination point is changed to the end of the path.
+func (a *Area) objectPath(ob Object, destX, destY float64) *path {
+	v, _ := a.paths.Load(ob.ID() + ob.Serial())
+	p, ok := v.(*path)
+	if ok && p.destX == destX && p.destY == destY {
+		return p
+	}
+	obX, obY := ob.Position()
+	waypoints, reachable := a.FindPath(obX, obY, destX, destY)
+	if !reachable {
+		destX, destY = obX, obY
+		if len(waypoints) > 0 {
+			destX, destY = waypoints[len(waypoints)-1].X, waypoints[len(waypoints)-1].Y
+		}
+		ob.SetDestPoint(destX, destY)
+	}
+	p = &path{destX, destY, waypoints}
+	a.paths.Store(ob.ID()+ob.Serial(), p)
+	return p
+}
+
+// tileNeighbours returns all passable tiles next to the tile
+// with specified index.
+// Diagonal tiles are passable only if both adjacent tiles
+// are passable too.
+func (a *Area) tileNeighbours(tile int) (tiles []int) {
+	columns, rows := a.Map().columns, a.Map().rows
+	column, row := tile%columns, tile/columns
+	passable := func(c, r int) bool {
+		return c >= 0 && r >= 0 && c < columns && r < rows &&
+			a.passableTile(c, r)
+	}
+	for _, d := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+		if passable(column+d[0], row+d[1]) {
+			tiles = append(tiles, (row+d[1])*columns+column+d[0])
+		}
+	}
+	for _, d := range [][2]int{{1, 1}, {1, -1}, {-1, 1}, {-1, -1}} {
+		if passable(column+d[0], row+d[1]) && passable(column+d[0], row) &&
+			passable(column, row+d[1]) {
+			tiles = append(tiles, (row+d[1])*columns+column+d[0])
+		}
+	}
+	return
+}
+
+// passableTile checks if map tile with specified column
+// and row is passable.
+func (a *Area) passableTile(column, row int) bool {
+	tile, ok := a.Map().tile(column, row)
+	if !ok {
+		return true
+	}
+	return tile.Properties().Passable
+}
+
+// tileMoveCost returns movement cost for the tile with
+// specified index.
+func (a *Area) tileMoveCost(tile int) float64 {
+	t, ok := a.Map().tile(tile%a.Map().columns, tile/a.Map().columns)
+	if !ok {
+		return 1
+	}
+	return t.Properties().MoveCost
+}
+
+// tileDistance returns distance between two tiles with
+// specified indexes on the grid with specified number
+// of columns.
+func tileDistance(tile1, tile2, columns int) float64 {
+	dx := math.Abs(float64(tile1%columns - tile2%columns))
+	dy := math.Abs(float64(tile1/columns - tile2/columns))
+	return math.Max(dx, dy) + (math.Sqrt2-1)*math.Min(dx, dy)
+}
+
+// Len returns length of the queue.
+func (q pathQueue) Len() int {
+	return len(q)
+}
+
+// Less checks if node with first specified index should
+// be popped before the node with second index.
+func (q pathQueue) Less(i, j int) bool {
+	return q[i].score < q[j].score
+}
+
+// Swap swaps nodes with specified indexes.
+func (q pathQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+// Push adds specified node to the queue.
+func (q *pathQueue) Push(x any) {
+	n := x.(*pathNode)
+	n.index = len(*q)
+	*q = append(*q, n)
+}
+
+// Pop removes and returns the last node from the queue.
+func (q *pathQueue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
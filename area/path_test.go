@@ -0,0 +1,182 @@
+/*
+ * path_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/isangeles/tmx"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+)
+
+// TestFindPath tests searching for path around
+// impassable map tiles.
+func TestFindPath(t *testing.T) {
+	// Create area
+	area, err := testPathArea()
+	if err != nil {
+		t.Fatalf("Unable to create test area: %v", err)
+	}
+	// Test
+	startX, startY := area.Map().tileCenter(1, 1)
+	destX, destY := area.Map().tileCenter(6, 1)
+	path, reachable := area.FindPath(startX, startY, destX, destY)
+	if !reachable {
+		t.Fatalf("Destination point should be reachable")
+	}
+	last := path[len(path)-1]
+	if last.X != destX || last.Y != destY {
+		t.Errorf("Invalid last waypoint: %f %f != %f %f", last.X, last.Y,
+			destX, destY)
+	}
+	gapX, gapY := area.Map().tileCenter(4, 8)
+	throughGap := false
+	for _, wp := range path {
+		if !area.passable(wp.X, wp.Y) {
+			t.Errorf("Waypoint on impassable position: %f %f", wp.X, wp.Y)
+		}
+		if wp.X == gapX && wp.Y == gapY {
+			throughGap = true
+		}
+	}
+	if !throughGap {
+		t.Errorf("Path is not leading through the gap in the wall")
+	}
+}
+
+// TestFindPathUnreachable tests searching for path to
+// unreachable destination point.
+func TestFindPathUnreachable(t *testing.T) {
+	// Create area
+	area, err := testPathArea()
+	if err != nil {
+		t.Fatalf("Unable to create test area: %v", err)
+	}
+	// Test
+	startX, startY := area.Map().tileCenter(6, 4)
+	destX, destY := area.Map().tileCenter(8, 1)
+	path, reachable := area.FindPath(startX, startY, destX, destY)
+	if reachable {
+		t.Fatalf("Destination point should be unreachable")
+	}
+	if len(path) < 1 {
+		t.Fatalf("No path to the closest reachable point")
+	}
+	last := path[len(path)-1]
+	column, row, _ := area.Map().tilePosition(last.X, last.Y)
+	if column != 8 || row != 3 {
+		t.Errorf("Path is not leading to the closest tile: %d %d != 8 3",
+			column, row)
+	}
+}
+
+// TestMoveObjectPath tests moving object along the path.
+func TestMoveObjectPath(t *testing.T) {
+	// Create object & area
+	area, err := testPathArea()
+	if err != nil {
+		t.Fatalf("Unable to create test area: %v", err)
+	}
+	ob := character.New(charData)
+	ob.SetPosition(area.Map().tileCenter(1, 1))
+	area.AddObject(ob)
+	// Test
+	destX, destY := area.Map().tileCenter(6, 1)
+	ob.SetDestPoint(destX, destY)
+	for i := 0; i < 2000; i++ {
+		area.Update(ob.BaseMoveCooldown())
+		x, y := ob.Position()
+		if !area.passable(x, y) {
+			t.Fatalf("Object moved to impassable position: %f %f", x, y)
+		}
+		if x == destX && y == destY {
+			break
+		}
+	}
+	x, y := ob.Position()
+	if x != destX || y != destY {
+		t.Errorf("Object not moved to the destination point: %f %f != %f %f",
+			x, y, destX, destY)
+	}
+}
+
+// TestObjectPath tests caching object paths.
+func TestObjectPath(t *testing.T) {
+	// Create object & area
+	area, err := testPathArea()
+	if err != nil {
+		t.Fatalf("Unable to create test area: %v", err)
+	}
+	ob := character.New(charData)
+	ob.SetPosition(area.Map().tileCenter(1, 1))
+	area.AddObject(ob)
+	// Test
+	ob.SetDestPoint(area.Map().tileCenter(6, 1))
+	area.Update(ob.BaseMoveCooldown())
+	path := area.ObjectPath(ob)
+	if len(path) < 1 {
+		t.Fatalf("No cached path for moving object")
+	}
+	area.Update(ob.BaseMoveCooldown())
+	if &area.ObjectPath(ob)[len(area.ObjectPath(ob))-1] != &path[len(path)-1] {
+		t.Errorf("Cached path was not reused")
+	}
+	destX, destY := area.Map().tileCenter(1, 6)
+	ob.SetDestPoint(destX, destY)
+	area.Update(ob.BaseMoveCooldown())
+	path = area.ObjectPath(ob)
+	last := path[len(path)-1]
+	if last.X != destX || last.Y != destY {
+		t.Errorf("Path not updated after destination change: %f %f != %f %f",
+			last.X, last.Y, destX, destY)
+	}
+	// Test unreachable destination
+	ob.SetDestPoint(area.Map().tileCenter(8, 1))
+	area.Update(ob.BaseMoveCooldown())
+	x, y := ob.DestPoint()
+	column, row, _ := area.Map().tilePosition(x, y)
+	if column == 8 && row == 1 {
+		t.Errorf("Unreachable destination point was not changed")
+	}
+}
+
+// testPathArea creates test area with map for
+// path searching.
+func testPathArea() (*Area, error) {
+	file, err := os.Open("testres/path.tmx")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open file: %v", err)
+	}
+	defer file.Close()
+	mapData, err := tmx.Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read map file: %v", err)
+	}
+	data := res.AreaData{ID: "area", Map: mapData}
+	return New(data), nil
+}
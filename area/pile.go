@@ -0,0 +1,268 @@
+/*
+ * pile.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"errors"
+	"math"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/item"
+	"github.com/isangeles/flame/serial"
+)
+
+const (
+	// ID of the item piles.
+	ItemPileID = "itemPile"
+	// Default time in milliseconds after which item
+	// piles are removed from the area, used for areas
+	// without item despawn time.
+	DefaultItemDespawn = 300000
+	// Maximal range between object and item pile
+	// to pick up items from the pile.
+	PickupRange = 50.0
+)
+
+var (
+	ITEM_NOT_FOUND = errors.New("item not found")
+	OUT_OF_RANGE   = errors.New("out of range")
+	LOCKED         = errors.New("locked")
+)
+
+// Struct for pile of items on the area ground.
+type ItemPile struct {
+	serial    string
+	posX      float64
+	posY      float64
+	despawn   int64
+	inventory *item.Inventory
+}
+
+// NewItemPile creates new item pile.
+func NewItemPile(data res.ItemPileData) *ItemPile {
+	p := ItemPile{inventory: item.NewInventory()}
+	p.Apply(data)
+	serial.Register(&p)
+	return &p
+}
+
+// ID returns item pile ID.
+func (p *ItemPile) ID() string {
+	return ItemPileID
+}
+
+// Serial returns item pile serial value.
+func (p *ItemPile) Serial() string {
+	return p.serial
+}
+
+// SetSerial sets serial value for the item pile.
+func (p *ItemPile) SetSerial(serial string) {
+	p.serial = serial
+}
+
+// Position returns position of the item pile.
+func (p *ItemPile) Position() (float64, float64) {
+	return p.posX, p.posY
+}
+
+// Inventory returns item pile inventory.
+func (p *ItemPile) Inventory() *item.Inventory {
+	return p.inventory
+}
+
+// Despawn returns time in milliseconds left
+// to remove the pile from area, negative value
+// means that the pile is never removed.
+func (p *ItemPile) Despawn() int64 {
+	return p.despawn
+}
+
+// Apply applies specified data on the item pile.
+func (p *ItemPile) Apply(data res.ItemPileData) {
+	p.serial = data.Serial
+	p.posX, p.posY = data.PosX, data.PosY
+	p.despawn = data.Despawn
+	p.Inventory().Apply(data.Inventory)
+}
+
+// Data returns data resource for the item pile.
+func (p *ItemPile) Data() res.ItemPileData {
+	data := res.ItemPileData{
+		Serial:    p.Serial(),
+		PosX:      p.posX,
+		PosY:      p.posY,
+		Despawn:   p.Despawn(),
+		Inventory: p.Inventory().Data(),
+	}
+	return data
+}
+
+// update updates item pile.
+func (p *ItemPile) update(delta int64) {
+	p.Inventory().Update(delta)
+	if p.despawn < 0 {
+		return
+	}
+	p.despawn = max(p.despawn-delta, 0)
+}
+
+// ItemDespawn returns time in milliseconds after which
+// item piles are removed from the area, negative value
+// means that item piles are never removed.
+func (a *Area) ItemDespawn() int64 {
+	return a.itemDespawn
+}
+
+// SetItemDespawn sets time in milliseconds after which
+// item piles are removed from the area, negative value
+// means that item piles are never removed.
+func (a *Area) SetItemDespawn(despawn int64) {
+	a.itemDespawn = despawn
+}
+
+// ItemPiles returns all item piles in the area.
+func (a *Area) ItemPiles() (piles []*ItemPile) {
+	addPile := func(k, v interface{}) bool {
+		p, ok := v.(*ItemPile)
+		if ok {
+			piles = append(piles, p)
+		}
+		return true
+	}
+	a.piles.Range(addPile)
+	return
+}
+
+// NearItemPiles returns all item piles within specified range
+// from specified XY position.
+func (a *Area) NearItemPiles(x, y, maxrange float64) (piles []*ItemPile) {
+	for _, p := range a.ItemPiles() {
+		posX, posY := p.Position()
+		if math.Hypot(posX-x, posY-y) <= maxrange {
+			piles = append(piles, p)
+		}
+	}
+	return
+}
+
+// SightRangeItemPiles returns all item piles in the sight
+// range of specified object, with clear line of sight
+// to the object.
+func (a *Area) SightRangeItemPiles(ob Object) (piles []*ItemPile) {
+	x, y := ob.Position()
+	for _, p := range a.NearItemPiles(x, y, ob.SightRange()) {
+		posX, posY := p.Position()
+		if a.LineOfSight(x, y, posX, posY) {
+			piles = append(piles, p)
+		}
+	}
+	return
+}
+
+// DropItem moves specified item from the inventory of
+// specified object to the item pile on the object
+// position.
+// Returns ITEM_NOT_FOUND error if object has no such item.
+func (a *Area) DropItem(ob Object, it item.Item) (*ItemPile, error) {
+	if ob.Inventory().Item(it.ID(), it.Serial()) == nil {
+		return nil, ITEM_NOT_FOUND
+	}
+	ob.Inventory().RemoveItem(it)
+	x, y := ob.Position()
+	var pile *ItemPile
+	for _, p := range a.NearItemPiles(x, y, 0) {
+		pile = p
+		break
+	}
+	if pile == nil {
+		pile = NewItemPile(res.ItemPileData{PosX: x, PosY: y})
+		a.piles.Store(pile.Serial(), pile)
+	}
+	pile.Inventory().AddItem(it)
+	pile.despawn = a.ItemDespawn()
+	return pile, nil
+}
+
+// PickupItem moves specified item from specified item pile
+// to the inventory of specified object.
+// Returns OUT_OF_RANGE error if the pile is too far from
+// the object, LOCKED error if the pile inventory is locked
+// and ITEM_NOT_FOUND error if there is no such item in the pile.
+func (a *Area) PickupItem(ob Object, pile *ItemPile, it item.Item) error {
+	x, y := ob.Position()
+	pileX, pileY := pile.Position()
+	if math.Hypot(pileX-x, pileY-y) > PickupRange {
+		return OUT_OF_RANGE
+	}
+	if pile.Inventory().Locked() {
+		return LOCKED
+	}
+	if pile.Inventory().Item(it.ID(), it.Serial()) == nil {
+		return ITEM_NOT_FOUND
+	}
+	pile.Inventory().RemoveItem(it)
+	ob.Inventory().AddItem(it)
+	if pile.Inventory().Size() < 1 {
+		a.piles.Delete(pile.Serial())
+	}
+	return nil
+}
+
+// updateItemPiles updates all item piles in the area
+// and removes empty and despawned piles.
+func (a *Area) updateItemPiles(delta int64) {
+	for _, p := range a.ItemPiles() {
+		p.update(delta)
+		if p.Despawn() == 0 || p.Inventory().Size() < 1 {
+			a.piles.Delete(p.Serial())
+		}
+	}
+}
+
+// applyItemPiles applies specified data on the area
+// item piles.
+func (a *Area) applyItemPiles(data []res.ItemPileData) {
+	for _, p := range a.ItemPiles() {
+		found := false
+		for _, pd := range data {
+			if pd.Serial == p.Serial() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a.piles.Delete(p.Serial())
+		}
+	}
+	for _, pd := range data {
+		v, _ := a.piles.Load(pd.Serial)
+		if p, ok := v.(*ItemPile); ok {
+			p.Apply(pd)
+			continue
+		}
+		p := NewItemPile(pd)
+		a.piles.Store(p.Serial(), p)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * pile_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/item"
+	"github.com/isangeles/flame/lock"
+)
+
+var pileItemData = res.MiscItemData{ID: "pileItem"}
+
+// TestDropItem tests dropping items on the area ground.
+func TestDropItem(t *testing.T) {
+	// Create area & object
+	area := New(res.AreaData{ID: "area"})
+	ob := character.New(charData)
+	ob.SetPosition(10, 10)
+	area.AddObject(ob)
+	it := item.NewMisc(pileItemData)
+	ob.Inventory().AddItem(it)
+	// Test
+	pile, err := area.DropItem(ob, it)
+	if err != nil {
+		t.Fatalf("Unable to drop item: %v", err)
+	}
+	if ob.Inventory().Item(it.ID(), it.Serial()) != nil {
+		t.Errorf("Item still in object inventory")
+	}
+	if pile.Inventory().Item(it.ID(), it.Serial()) == nil {
+		t.Errorf("Item not in item pile")
+	}
+	if len(area.SightRangeItemPiles(ob)) != 1 {
+		t.Errorf("Item pile not visible")
+	}
+	if _, err := area.DropItem(ob, it); err != ITEM_NOT_FOUND {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+}
+
+// TestPickupItem tests picking up items from the area ground.
+func TestPickupItem(t *testing.T) {
+	// Create area & object
+	area := New(res.AreaData{ID: "area"})
+	ob := character.New(charData)
+	ob.SetPosition(10, 10)
+	area.AddObject(ob)
+	it := item.NewMisc(pileItemData)
+	ob.Inventory().AddItem(it)
+	pile, _ := area.DropItem(ob, it)
+	// Test
+	ob.SetPosition(10+PickupRange+1, 10)
+	if err := area.PickupItem(ob, pile, it); err != OUT_OF_RANGE {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+	ob.SetPosition(10, 10)
+	pile.Inventory().SetLock(lock.New(res.LockData{Locked: true}))
+	if err := area.PickupItem(ob, pile, it); err != LOCKED {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+	pile.Inventory().SetLock(nil)
+	if err := area.PickupItem(ob, pile, it); err != nil {
+		t.Fatalf("Unable to pick up item: %v", err)
+	}
+	if ob.Inventory().Item(it.ID(), it.Serial()) == nil {
+		t.Errorf("Item not in object inventory")
+	}
+	if len(area.ItemPiles()) != 0 {
+		t.Errorf("Empty item pile not removed")
+	}
+}
+
+// TestItemPileDespawn tests removing and restoring item piles.
+func TestItemPileDespawn(t *testing.T) {
+	// Create area & object
+	res.Miscs = append(res.Miscs, pileItemData)
+	area := New(res.AreaData{ID: "area", ItemDespawn: 100})
+	ob := character.New(charData)
+	area.AddObject(ob)
+	it := item.NewMisc(pileItemData)
+	ob.Inventory().AddItem(it)
+	area.DropItem(ob, it)
+	// Test
+	area.Update(50)
+	data := area.Data()
+	if len(data.ItemPiles) != 1 {
+		t.Fatalf("Invalid number of item piles data: %d != 1", len(data.ItemPiles))
+	}
+	if data.ItemPiles[0].Despawn != 50 {
+		t.Errorf("Invalid despawn time: %d != 50", data.ItemPiles[0].Despawn)
+	}
+	restored := New(data)
+	if len(restored.ItemPiles()) != 1 {
+		t.Fatalf("Item pile not restored")
+	}
+	if restored.ItemPiles()[0].Inventory().Size() != 1 {
+		t.Errorf("Item pile inventory not restored")
+	}
+	area.Update(50)
+	if len(area.ItemPiles()) != 0 {
+		t.Errorf("Item pile not despawned")
+	}
+}
+
+// TestItemPileNoDespawn tests item piles in area
+// without item despawn.
+func TestItemPileNoDespawn(t *testing.T) {
+	// Create area & object
+	res.Miscs = append(res.Miscs, pileItemData)
+	area := New(res.AreaData{ID: "area", ItemDespawn: -1})
+	ob := character.New(charData)
+	area.AddObject(ob)
+	it := item.NewMisc(pileItemData)
+	ob.Inventory().AddItem(it)
+	area.DropItem(ob, it)
+	// Test
+	area.Update(DefaultItemDespawn * 2)
+	if len(area.ItemPiles()) != 1 {
+		t.Errorf("Item pile despawned in area without item despawn")
+	}
+	data := area.Data()
+	restored := New(data)
+	restored.Update(DefaultItemDespawn * 2)
+	if len(restored.ItemPiles()) != 1 {
+		t.Errorf("Restored item pile despawned in area without item despawn")
+	}
+}
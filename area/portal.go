@@ -0,0 +1,165 @@
+/*
+ * portal.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"sync"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/lock"
+	"github.com/isangeles/flame/req"
+)
+
+// Struct for area portal.
+type Portal struct {
+	id           string
+	region       Region
+	destArea     string
+	destX, destY float64
+	reqs         []req.Requirement
+	lock         *lock.Lock
+	objects      *sync.Map
+}
+
+// NewPortal creates new area portal.
+func NewPortal(data res.PortalData) *Portal {
+	p := Portal{objects: new(sync.Map)}
+	p.Apply(data)
+	return &p
+}
+
+// ID returns portal ID.
+func (p *Portal) ID() string {
+	return p.id
+}
+
+// Region returns portal region.
+func (p *Portal) Region() Region {
+	return p.region
+}
+
+// DestArea returns ID of the portal destination area.
+// Empty ID means the area of the portal.
+func (p *Portal) DestArea() string {
+	return p.destArea
+}
+
+// DestPoint returns position in the destination
+// area.
+func (p *Portal) DestPoint() (float64, float64) {
+	return p.destX, p.destY
+}
+
+// Requirements returns portal requirements.
+func (p *Portal) Requirements() []req.Requirement {
+	return p.reqs
+}
+
+// Lock returns portal lock or nil if portal has
+// no lock.
+func (p *Portal) Lock() *lock.Lock {
+	return p.lock
+}
+
+// SetLock sets specified lock for the portal.
+func (p *Portal) SetLock(l *lock.Lock) {
+	p.lock = l
+}
+
+// Locked checks if portal is locked.
+func (p *Portal) Locked() bool {
+	return p.Lock() != nil && p.Lock().Locked()
+}
+
+// Apply applies specified data on the portal.
+func (p *Portal) Apply(data res.PortalData) {
+	p.id = data.ID
+	p.region = NewRegion(data.Region)
+	p.destArea = data.DestArea
+	p.destX, p.destY = data.DestX, data.DestY
+	p.reqs = req.NewRequirements(data.Requirements)
+	switch {
+	case !lock.HasData(data.Lock):
+		p.lock = nil
+	case p.lock == nil:
+		p.lock = lock.New(data.Lock)
+	default:
+		p.lock.Apply(data.Lock)
+	}
+}
+
+// Data returns data resource for portal.
+func (p *Portal) Data() res.PortalData {
+	data := res.PortalData{
+		ID:           p.ID(),
+		DestArea:     p.DestArea(),
+		DestX:        p.destX,
+		DestY:        p.destY,
+		Region:       p.Region().Data(),
+		Requirements: req.RequirementsData(p.Requirements()...),
+	}
+	if p.Lock() != nil {
+		data.Lock = p.Lock().Data()
+	}
+	return data
+}
+
+// enter updates portal state for specified object.
+// Returns true if object just entered the portal region,
+// meets portal requirements and the portal is not locked.
+func (p *Portal) enter(ob Object) bool {
+	x, y := ob.Position()
+	if !p.Region().Contains(x, y) {
+		p.objects.Delete(ob.ID() + ob.Serial())
+		return false
+	}
+	if _, inside := p.objects.Load(ob.ID() + ob.Serial()); inside {
+		return false
+	}
+	if p.Locked() || !p.meetReqs(ob) {
+		return false
+	}
+	p.objects.Store(ob.ID()+ob.Serial(), ob)
+	return true
+}
+
+// update updates portal.
+func (p *Portal) update(delta int64) {
+	if p.Lock() != nil {
+		p.Lock().Update(delta)
+	}
+}
+
+// meetReqs checks if specified object meets portal
+// requirements.
+func (p *Portal) meetReqs(ob Object) bool {
+	if len(p.Requirements()) < 1 {
+		return true
+	}
+	rt, ok := ob.(req.RequirementsTarget)
+	if !ok {
+		return false
+	}
+	return rt.MeetReqs(p.Requirements()...)
+}
@@ -0,0 +1,101 @@
+/*
+ * region.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"math"
+
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for area region.
+type Region struct {
+	x, y, width, height float64
+	points              []res.PointData
+}
+
+// NewRegion creates new area region.
+func NewRegion(data res.RegionData) Region {
+	r := Region{
+		x:      data.X,
+		y:      data.Y,
+		width:  data.Width,
+		height: data.Height,
+	}
+	if len(data.Points) > 2 {
+		r.points = data.Points
+	}
+	return r
+}
+
+// Contains checks if specified XY position is
+// inside the region.
+func (r Region) Contains(x, y float64) bool {
+	if len(r.points) < 3 {
+		return x >= r.x && y >= r.y && x <= r.x+r.width && y <= r.y+r.height
+	}
+	inside := false
+	j := len(r.points) - 1
+	for i, p := range r.points {
+		pj := r.points[j]
+		if (p.Y > y) != (pj.Y > y) &&
+			x < (pj.X-p.X)*(y-p.Y)/(pj.Y-p.Y)+p.X {
+			inside = !inside
+		}
+		j = i
+	}
+	return inside
+}
+
+// bounds returns bounding box of the region.
+func (r Region) bounds() (minX, minY, maxX, maxY float64) {
+	if len(r.points) < 3 {
+		return r.x, r.y, r.x + r.width, r.y + r.height
+	}
+	minX, minY = r.points[0].X, r.points[0].Y
+	maxX, maxY = minX, minY
+	for _, p := range r.points[1:] {
+		minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+	return
+}
+
+// emptyRegion checks if specified region data
+// describes an empty region.
+func emptyRegion(data res.RegionData) bool {
+	return len(data.Points) < 3 && (data.Width <= 0 || data.Height <= 0)
+}
+
+// Data returns data resource for region.
+func (r Region) Data() res.RegionData {
+	data := res.RegionData{
+		X:      r.x,
+		Y:      r.y,
+		Width:  r.width,
+		Height: r.height,
+		Points: r.points,
+	}
+	return data
+}
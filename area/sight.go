@@ -0,0 +1,62 @@
+/*
+ * sight.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"math"
+)
+
+// LineOfSight checks if there is a clear line of sight
+// between specified XY positions, i.e. there is no map
+// tile that blocks sight between those positions.
+// Tiles with start and end positions are not checked.
+func (a *Area) LineOfSight(x1, y1, x2, y2 float64) bool {
+	tileWidth, tileHeight := a.Map().TileSize()
+	if tileWidth < 1 || tileHeight < 1 {
+		return true
+	}
+	startColumn, startRow, _ := a.Map().tilePosition(x1, y1)
+	endColumn, endRow, _ := a.Map().tilePosition(x2, y2)
+	step := float64(min(tileWidth, tileHeight)) / 4
+	dist := math.Hypot(x2-x1, y2-y1)
+	for d := step; d < dist; d += step {
+		x := x1 + (x2-x1)*d/dist
+		y := y1 + (y2-y1)*d/dist
+		column, row, ok := a.Map().tilePosition(x, y)
+		if !ok {
+			continue
+		}
+		if column == startColumn && row == startRow {
+			continue
+		}
+		if column == endColumn && row == endRow {
+			continue
+		}
+		tile, ok := a.Map().tile(column, row)
+		if ok && tile.Properties().BlocksSight {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+/*
+ * sight_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+)
+
+// TestLineOfSight tests checking line of sight between
+// positions on the area map.
+func TestLineOfSight(t *testing.T) {
+	// Create area
+	mapData, err := testPropsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	area := New(res.AreaData{ID: "area", Map: mapData})
+	// Test
+	x1, y1 := area.Map().tileCenter(1, 0)
+	x2, y2 := area.Map().tileCenter(3, 0)
+	if !area.LineOfSight(x1, y1, x2, y2) {
+		t.Errorf("Line of sight should be clear: %f %f - %f %f", x1, y1, x2, y2)
+	}
+	x2, y2 = area.Map().tileCenter(5, 0)
+	if area.LineOfSight(x1, y1, x2, y2) {
+		t.Errorf("Line of sight should be blocked: %f %f - %f %f", x1, y1, x2, y2)
+	}
+	x2, y2 = area.Map().tileCenter(5, 1)
+	if !area.LineOfSight(x1, y1-32, x2, y2) {
+		t.Errorf("Line of sight should be clear: %f %f - %f %f", x1, y1-32, x2, y2)
+	}
+}
+
+// TestSightBlocked tests sight of area objects behind
+// sight-blocking map tiles.
+func TestSightBlocked(t *testing.T) {
+	// Create area & objects
+	mapData, err := testPropsMap()
+	if err != nil {
+		t.Fatalf("Unable to get test map data: %v", err)
+	}
+	area := New(res.AreaData{ID: "area", Map: mapData})
+	char1 := character.New(charData)
+	char1.SetPosition(area.Map().tileCenter(3, 0))
+	area.AddObject(char1)
+	char2 := character.New(charData)
+	char2.SetPosition(area.Map().tileCenter(5, 0))
+	area.AddObject(char2)
+	// Test
+	if char1.InSight(char2.Position()) {
+		t.Errorf("Object should not be in sight")
+	}
+	x, y := char2.Position()
+	if containsObject(char1.ID(), char1.Serial(), area.SightRangeObjects(x, y)...) {
+		t.Errorf("Object should not be among returned objects: %s %s",
+			char1.ID(), char1.Serial())
+	}
+	area.RemoveObject(char1)
+	if char1.Environment() != nil {
+		t.Errorf("Environment not removed from the object")
+	}
+	if !char1.InSight(char2.Position()) {
+		t.Errorf("Object should be in sight without environment")
+	}
+}
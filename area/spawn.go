@@ -25,7 +25,6 @@ package area
 
 import (
 	"sync"
-	"time"
 
 	"github.com/isangeles/flame/character"
 	"github.com/isangeles/flame/data/res"
@@ -38,36 +37,35 @@ type Spawn struct {
 	area         *Area
 	respawnQueue *sync.Map
 	despawnQueue *sync.Map
+	spawners     *sync.Map
 }
 
 // newSpawn creates spawn for the area.
 func newSpawn(area *Area) *Spawn {
 	r := Spawn{
-		area:  area,
+		area:         area,
 		respawnQueue: new(sync.Map),
 		despawnQueue: new(sync.Map),
+		spawners:     new(sync.Map),
 	}
 	return &r
 }
 
 // Update updates spawn.
-func (r *Spawn) Update() {
-	// Fill the queues
-	for _, ob := range r.area.Objects() {
-		_, inQueue := r.respawnQueue.Load(ob)
-		if !inQueue && !ob.Live() && ob.Respawn() > 0 {
-			r.respawnQueue.Store(ob, r.area.Time.Add(time.Duration(ob.Respawn())*time.Millisecond))
-		}
-		_, inQueue = r.despawnQueue.Load(ob)
-		if !inQueue && ob.OpenLoot() && len(ob.Inventory().Items()) < 1 && ob.Despawn() > 0 {
-			r.despawnQueue.Store(ob, r.area.Time.Add(time.Duration(ob.Despawn())*time.Millisecond))
-		}
-	}
+// Respawn and despawn times are counted in real
+// milliseconds, independently from the area clock
+// scale.
+func (r *Spawn) Update(delta int64) {
 	// Respawn
 	respObject := func(k, v interface{}) bool {
 		ob, keyOk := k.(serial.Serialer)
-		respTime, valueOk := v.(time.Time)
-		if !keyOk || !valueOk || respTime.Unix() > r.area.Time.Unix(){
+		respTime, valueOk := v.(int64)
+		if !keyOk || !valueOk {
+			return true
+		}
+		respTime -= delta
+		if respTime > 0 {
+			r.respawnQueue.Store(ob, respTime)
 			return true
 		}
 		if char, ok := ob.(*character.Character); ok && !char.Live() {
@@ -80,8 +78,13 @@ func (r *Spawn) Update() {
 	// Despawn
 	despObject := func(k, v interface{}) bool {
 		ob, keyOk := k.(serial.Serialer)
-		despTime, valueOk := v.(time.Time)
-		if !keyOk || !valueOk || despTime.Unix() > r.area.Time.Unix() {
+		despTime, valueOk := v.(int64)
+		if !keyOk || !valueOk {
+			return true
+		}
+		despTime -= delta
+		if despTime > 0 {
+			r.despawnQueue.Store(ob, despTime)
 			return true
 		}
 		if char, ok := ob.(*character.Character); ok {
@@ -91,25 +94,65 @@ func (r *Spawn) Update() {
 		return true
 	}
 	r.despawnQueue.Range(despObject)
+	// Fill the queues
+	for _, ob := range r.area.Objects() {
+		_, inQueue := r.respawnQueue.Load(ob)
+		if !inQueue && !ob.Live() && ob.Respawn() > 0 {
+			r.respawnQueue.Store(ob, ob.Respawn())
+		}
+		_, inQueue = r.despawnQueue.Load(ob)
+		if !inQueue && ob.OpenLoot() && len(ob.Inventory().Items()) < 1 && ob.Despawn() > 0 {
+			r.despawnQueue.Store(ob, ob.Despawn())
+		}
+	}
 }
 
 // Apply applies respawn data.
 func (r *Spawn) Apply(data res.SpawnData) {
 	r.respawnQueue = new(sync.Map)
+	r.despawnQueue = new(sync.Map)
 	for _, ob := range data.RespawnQueue {
 		areaOb, _ := r.area.objects.Load(ob.ID + ob.Serial)
 		if _, ok := areaOb.(*character.Character); ok {
-			r.respawnQueue.Store(time.Unix(ob.Time, 0), areaOb)
+			r.respawnQueue.Store(areaOb, ob.Time)
 			continue
 		}
 	}
 	for _, ob := range data.DespawnQueue {
 		areaOb, _ := r.area.objects.Load(ob.ID + ob.Serial)
 		if _, ok := areaOb.(*character.Character); ok {
-			r.despawnQueue.Store(time.Unix(ob.Time, 0), areaOb)
+			r.despawnQueue.Store(areaOb, ob.Time)
 			continue
 		}
 	}
+	for _, sd := range data.Spawners {
+		v, _ := r.spawners.Load(sd.ID)
+		s, ok := v.(*Spawner)
+		if !ok {
+			r.AddSpawner(NewSpawner(sd))
+			continue
+		}
+		s.Apply(sd)
+	}
+}
+
+// Spawners returns all area spawners.
+func (r *Spawn) Spawners() (spawners []*Spawner) {
+	addSpawner := func(k, v interface{}) bool {
+		s, ok := v.(*Spawner)
+		if ok {
+			spawners = append(spawners, s)
+		}
+		return true
+	}
+	r.spawners.Range(addSpawner)
+	return
+}
+
+// AddSpawner adds specified spawner to the area.
+func (r *Spawn) AddSpawner(s *Spawner) {
+	s.area = r.area
+	r.spawners.Store(s.ID(), s)
 }
 
 // Data returns data resource for respawn.
@@ -117,13 +160,13 @@ func (r *Spawn) Data() res.SpawnData {
 	var data res.SpawnData
 	addSpawnObject := func(k, v interface{}) bool {
 		ob, keyOk := k.(serial.Serialer)
-		time, valueOk := v.(time.Time)
+		time, valueOk := v.(int64)
 		if !keyOk || !valueOk {
 			return true
 		}
 		obData := res.SpawnObject{
 			SerialObjectData: res.SerialObjectData{ob.ID(), ob.Serial()},
-			Time:             time.Unix(),
+			Time:             time,
 		}
 		data.RespawnQueue = append(data.RespawnQueue, obData)
 		return true
@@ -131,21 +174,31 @@ func (r *Spawn) Data() res.SpawnData {
 	r.respawnQueue.Range(addSpawnObject)
 	addDespawnObject := func(k, v interface{}) bool {
 		ob, keyOk := k.(serial.Serialer)
-		time, valueOk := v.(time.Time)
+		time, valueOk := v.(int64)
 		if !keyOk || !valueOk {
 			return true
 		}
 		obData := res.SpawnObject{
 			SerialObjectData: res.SerialObjectData{ob.ID(), ob.Serial()},
-			Time:             time.Unix(),
+			Time:             time,
 		}
 		data.DespawnQueue = append(data.DespawnQueue, obData)
 		return true
 	}
 	r.despawnQueue.Range(addDespawnObject)
+	for _, s := range r.Spawners() {
+		data.Spawners = append(data.Spawners, s.Data())
+	}
 	return data
 }
 
+// updateSpawners updates all area spawners.
+func (r *Spawn) updateSpawners(delta int64) {
+	for _, s := range r.Spawners() {
+		s.update(delta)
+	}
+}
+
 // respawnChar respawns specified character.
 func (r *Spawn) respawnChar(char *character.Character) {
 	charData := res.Character(char.ID(), "")
@@ -156,6 +209,7 @@ func (r *Spawn) respawnChar(char *character.Character) {
 	}
 	newChar := character.New(*charData)
 	newChar.SetRespawn(char.Respawn())
+	newChar.SetAI(char.AI())
 	newChar.SetPosition(char.DefaultPosition())
 	newChar.SetDefaultPosition(char.DefaultPosition())
 	for _, f := range char.Flags() {
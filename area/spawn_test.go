@@ -26,10 +26,19 @@ package area
 import (
 	"testing"
 
-	"github.com/isangeles/flame/data/res"
 	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/clock"
+	"github.com/isangeles/flame/data/res"
 )
 
+var spawnerData = res.SpawnerData{
+	ID:         "spawner",
+	Max:        2,
+	Interval:   1000,
+	Region:     res.RegionData{X: 10, Y: 10, Width: 50, Height: 50},
+	Characters: []res.SpawnerCharData{{ID: "char", Weight: 1}},
+}
+
 // TestAreaRespawn tests respawn for area.
 func TestAreaRespawn(t *testing.T) {
 	// Create object & area
@@ -51,6 +60,32 @@ func TestAreaRespawn(t *testing.T) {
 	}
 }
 
+// TestAreaRespawnClockScale tests if respawn time is
+// not affected by the area clock scale.
+func TestAreaRespawnClockScale(t *testing.T) {
+	// Create object & area
+	res.Characters = append(res.Characters, charData)
+	ob := character.New(charData)
+	ob.SetRespawn(1000)
+	area := New(areaData)
+	area.SetClock(clock.New(res.ClockData{Scale: 60}))
+	area.AddObject(ob)
+	// Test
+	ob.SetHealth(0)
+	area.Clock().Update(1)
+	area.Update(1)
+	area.Clock().Update(100)
+	area.Update(100)
+	if area.Objects()[0] != ob {
+		t.Errorf("Object respawned before respawn time")
+	}
+	area.Clock().Update(900)
+	area.Update(900)
+	if area.Objects()[0] == ob {
+		t.Errorf("Object was not respawned")
+	}
+}
+
 // TestAreaDespawn tests despawn for area.
 func TestAreaDespawn(t *testing.T) {
 	// Create object & area
@@ -66,3 +101,77 @@ func TestAreaDespawn(t *testing.T) {
 		t.Errorf("Object not despawned")
 	}
 }
+
+// TestAreaRespawnData tests saving and restoring
+// of the respawn queue.
+func TestAreaRespawnData(t *testing.T) {
+	// Create object & area
+	ob := character.New(charData)
+	ob.SetRespawn(1000)
+	area := New(areaData)
+	area.AddObject(ob)
+	ob.SetHealth(0)
+	area.Update(1)
+	// Test
+	data := area.Data()
+	if len(data.Spawn.RespawnQueue) != 1 {
+		t.Fatalf("Invalid respawn queue length: %d != 1", len(data.Spawn.RespawnQueue))
+	}
+	res.Characters = append(res.Characters, ob.Data())
+	restored := New(data)
+	data = restored.Data()
+	if len(data.Spawn.RespawnQueue) != 1 {
+		t.Errorf("Invalid restored respawn queue length: %d != 1",
+			len(data.Spawn.RespawnQueue))
+	}
+}
+
+// TestAreaSpawner tests spawning characters by area spawners.
+func TestAreaSpawner(t *testing.T) {
+	// Create area
+	res.Characters = append(res.Characters, charData)
+	data := areaData
+	data.Spawn.Spawners = []res.SpawnerData{spawnerData}
+	area := New(data)
+	if len(area.spawn.Spawners()) != 1 {
+		t.Fatalf("Invalid number of spawners: %d != 1", len(area.spawn.Spawners()))
+	}
+	spawner := area.spawn.Spawners()[0]
+	// Test
+	for i := 0; i < 3; i++ {
+		area.Update(1000)
+	}
+	if len(spawner.Population()) != 2 {
+		t.Fatalf("Invalid spawner population: %d != 2", len(spawner.Population()))
+	}
+	if len(area.Objects()) != 2 {
+		t.Errorf("Invalid number of area objects: %d != 2", len(area.Objects()))
+	}
+	for _, c := range spawner.Population() {
+		if !spawner.Region().Contains(c.Position()) {
+			x, y := c.Position()
+			t.Errorf("Spawned character outside spawner region: %fx%f", x, y)
+		}
+	}
+	spawner.Population()[0].SetHealth(0)
+	area.Update(1)
+	if len(spawner.Population()) != 1 {
+		t.Errorf("Invalid spawner population: %d != 1", len(spawner.Population()))
+	}
+	spawnerData := spawner.Data()
+	if len(spawnerData.Spawned) != 1 {
+		t.Errorf("Invalid number of spawned characters in data: %d != 1",
+			len(spawnerData.Spawned))
+	}
+	area.Update(1000)
+	if len(spawner.Population()) != 2 {
+		t.Errorf("Invalid spawner population: %d != 2", len(spawner.Population()))
+	}
+	if len(area.Objects()) != 3 {
+		t.Errorf("Invalid number of area objects: %d != 3", len(area.Objects()))
+	}
+	area.Update(DefaultSpawnerDespawn)
+	if len(area.Objects()) != 2 {
+		t.Errorf("Dead spawned character not removed: %d != 2", len(area.Objects()))
+	}
+}
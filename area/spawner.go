@@ -0,0 +1,207 @@
+/*
+ * spawner.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/rng"
+)
+
+// Struct for area spawner.
+type Spawner struct {
+	id       string
+	area     *Area
+	region   Region
+	interval int64 // millis
+	max      int
+	despawn  int64 // millis
+	time     int64 // millis
+	table    []res.SpawnerCharData
+	spawned  []res.SerialObjectData
+}
+
+const (
+	// Number of attempts to find free position
+	// for spawned character.
+	spawnPositionAttempts = 10
+	// Default time in milliseconds after which dead
+	// spawned characters are removed from the area.
+	DefaultSpawnerDespawn = 60000
+)
+
+// NewSpawner creates new area spawner.
+func NewSpawner(data res.SpawnerData) *Spawner {
+	s := new(Spawner)
+	s.Apply(data)
+	return s
+}
+
+// ID returns spawner ID.
+func (s *Spawner) ID() string {
+	return s.id
+}
+
+// Region returns spawner region.
+func (s *Spawner) Region() Region {
+	return s.region
+}
+
+// Max returns spawner population cap.
+func (s *Spawner) Max() int {
+	return s.max
+}
+
+// Population returns all live characters spawned
+// by the spawner.
+func (s *Spawner) Population() (chars []*character.Character) {
+	if s.area == nil {
+		return
+	}
+	for _, sd := range s.spawned {
+		ob, _ := s.area.objects.Load(sd.ID + sd.Serial)
+		char, ok := ob.(*character.Character)
+		if ok && char.Live() {
+			chars = append(chars, char)
+		}
+	}
+	return
+}
+
+// Apply applies specified data on the spawner.
+func (s *Spawner) Apply(data res.SpawnerData) {
+	s.id = data.ID
+	s.region = NewRegion(data.Region)
+	s.interval = data.Interval
+	s.max = data.Max
+	s.despawn = data.Despawn
+	if s.despawn < 1 {
+		s.despawn = DefaultSpawnerDespawn
+	}
+	s.time = data.Time
+	s.table = data.Characters
+	s.spawned = data.Spawned
+}
+
+// Data returns data resource for spawner.
+func (s *Spawner) Data() res.SpawnerData {
+	data := res.SpawnerData{
+		ID:         s.ID(),
+		Region:     s.Region().Data(),
+		Interval:   s.interval,
+		Max:        s.Max(),
+		Despawn:    s.despawn,
+		Time:       s.time,
+		Characters: s.table,
+		Spawned:    s.spawned,
+	}
+	return data
+}
+
+// update updates spawner.
+// Removes dead or missing characters from the spawner
+// population and spawns new character when spawn
+// interval passes.
+// Dead spawned characters are added to the area
+// despawn queue.
+func (s *Spawner) update(delta int64) {
+	for _, sd := range s.spawned {
+		ob, _ := s.area.objects.Load(sd.ID + sd.Serial)
+		char, ok := ob.(*character.Character)
+		if !ok || char.Live() {
+			continue
+		}
+		if _, queued := s.area.spawn.despawnQueue.Load(char); !queued {
+			s.area.spawn.despawnQueue.Store(char, s.despawn)
+		}
+	}
+	population := s.Population()
+	s.spawned = nil
+	for _, c := range population {
+		s.spawned = append(s.spawned, res.SerialObjectData{c.ID(), c.Serial()})
+	}
+	s.time -= delta
+	if s.time > 0 {
+		return
+	}
+	s.time = s.interval
+	if len(s.spawned) >= s.Max() {
+		return
+	}
+	s.spawn()
+}
+
+// spawn spawns random character from the spawner table
+// at random free position inside the spawner region.
+// Spawned characters are controlled by AI.
+func (s *Spawner) spawn() {
+	id := s.rollCharacter()
+	if len(id) < 1 {
+		return
+	}
+	charData := res.Character(id, "")
+	if charData == nil {
+		log.Err.Printf("Area: %s: spawner: %s: character data not found: %s",
+			s.area.ID(), s.ID(), id)
+		return
+	}
+	char := character.New(*charData)
+	minX, minY, maxX, maxY := s.Region().bounds()
+	for i := 0; i < spawnPositionAttempts; i++ {
+		x := float64(rng.RollInt(int(minX), int(maxX)))
+		y := float64(rng.RollInt(int(minY), int(maxY)))
+		if !s.Region().Contains(x, y) || !s.area.free(char, x, y) {
+			continue
+		}
+		char.SetPosition(x, y)
+		char.SetDefaultPosition(x, y)
+		char.SetAI(true)
+		char.SetRespawn(0)
+		s.area.AddObject(char)
+		s.spawned = append(s.spawned, res.SerialObjectData{char.ID(), char.Serial()})
+		return
+	}
+}
+
+// rollCharacter returns ID of random character from
+// the spawner table, depending on the characters
+// weights.
+func (s *Spawner) rollCharacter() string {
+	total := 0
+	for _, c := range s.table {
+		total += c.Weight
+	}
+	if total < 1 {
+		return ""
+	}
+	roll := rng.RollInt(1, total)
+	for _, c := range s.table {
+		roll -= c.Weight
+		if roll <= 0 {
+			return c.ID
+		}
+	}
+	return ""
+}
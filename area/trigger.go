@@ -0,0 +1,239 @@
+/*
+ * trigger.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"sync"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/req"
+)
+
+// Struct for area trigger.
+type Trigger struct {
+	id           string
+	region       Region
+	once         bool
+	fired        bool
+	stayInterval int64
+	reqs         []req.Requirement
+	enterMods    []effect.Modifier
+	leaveMods    []effect.Modifier
+	stayMods     []effect.Modifier
+	enterEffects []res.EffectData
+	leaveEffects []res.EffectData
+	stayEffects  []res.EffectData
+	objects      *sync.Map
+}
+
+// Struct for object inside the trigger region.
+type triggerObject struct {
+	id, serial string
+	time       int64
+}
+
+const defaultStayInterval = 1000 // millis
+
+// NewTrigger creates new area trigger.
+func NewTrigger(data res.TriggerData) *Trigger {
+	t := Trigger{objects: new(sync.Map)}
+	t.Apply(data)
+	return &t
+}
+
+// ID returns trigger ID.
+func (t *Trigger) ID() string {
+	return t.id
+}
+
+// Region returns trigger region.
+func (t *Trigger) Region() Region {
+	return t.region
+}
+
+// Requirements returns trigger requirements.
+func (t *Trigger) Requirements() []req.Requirement {
+	return t.reqs
+}
+
+// Once checks if trigger should fire only once.
+func (t *Trigger) Once() bool {
+	return t.once
+}
+
+// Active checks if objects can enter the trigger.
+// Trigger that should fire only once is not active
+// after firing.
+func (t *Trigger) Active() bool {
+	return !t.once || !t.fired
+}
+
+// StayInterval returns time in milliseconds between
+// applying stay modifiers and effects on objects inside
+// the trigger region.
+func (t *Trigger) StayInterval() int64 {
+	if t.stayInterval < 1 {
+		return defaultStayInterval
+	}
+	return t.stayInterval
+}
+
+// Apply applies specified data on the trigger.
+func (t *Trigger) Apply(data res.TriggerData) {
+	t.id = data.ID
+	t.region = NewRegion(data.Region)
+	t.once = data.Once
+	t.fired = data.Fired
+	t.stayInterval = data.StayInterval
+	t.reqs = req.NewRequirements(data.Requirements)
+	t.enterMods = effect.NewModifiers(data.EnterMods)
+	t.leaveMods = effect.NewModifiers(data.LeaveMods)
+	t.stayMods = effect.NewModifiers(data.StayMods)
+	t.enterEffects = triggerEffects(data.EnterEffects)
+	t.leaveEffects = triggerEffects(data.LeaveEffects)
+	t.stayEffects = triggerEffects(data.StayEffects)
+	t.objects = new(sync.Map)
+	for _, od := range data.Objects {
+		ob := triggerObject{od.ID, od.Serial, od.Time}
+		t.objects.Store(od.ID+od.Serial, &ob)
+	}
+}
+
+// Data returns data resource for trigger.
+func (t *Trigger) Data() res.TriggerData {
+	data := res.TriggerData{
+		ID:           t.ID(),
+		Once:         t.once,
+		Fired:        t.fired,
+		StayInterval: t.stayInterval,
+		Region:       t.Region().Data(),
+		Requirements: req.RequirementsData(t.Requirements()...),
+		EnterMods:    effect.ModifiersData(t.enterMods...),
+		LeaveMods:    effect.ModifiersData(t.leaveMods...),
+		StayMods:     effect.ModifiersData(t.stayMods...),
+		EnterEffects: triggerEffectsData(t.enterEffects),
+		LeaveEffects: triggerEffectsData(t.leaveEffects),
+		StayEffects:  triggerEffectsData(t.stayEffects),
+	}
+	addObject := func(k, v interface{}) bool {
+		ob, ok := v.(*triggerObject)
+		if ok {
+			data.Objects = append(data.Objects, res.TriggerObjectData{ob.id, ob.serial, ob.time})
+		}
+		return true
+	}
+	t.objects.Range(addObject)
+	return data
+}
+
+// update updates trigger state for specified object.
+// Returns true if object entered the trigger region,
+// or false if object left the region, and true as second
+// value if the state of the trigger was changed.
+func (t *Trigger) update(ob Object) (bool, bool) {
+	x, y := ob.Position()
+	inside := ob.Live() && t.Region().Contains(x, y) && t.meetReqs(ob)
+	_, wasInside := t.objects.Load(ob.ID() + ob.Serial())
+	switch {
+	case inside && !wasInside:
+		if !t.Active() {
+			return false, false
+		}
+		t.objects.Store(ob.ID()+ob.Serial(), &triggerObject{ob.ID(), ob.Serial(), 0})
+		t.fired = true
+		t.apply(ob, t.enterMods, t.enterEffects)
+		return true, true
+	case !inside && wasInside:
+		t.objects.Delete(ob.ID() + ob.Serial())
+		t.apply(ob, t.leaveMods, t.leaveEffects)
+		return false, true
+	}
+	return inside, false
+}
+
+// stay updates stay time of specified object inside the
+// trigger region.
+// Returns true if stay modifiers and effects were applied
+// on the object.
+func (t *Trigger) stay(ob Object, delta int64) bool {
+	v, _ := t.objects.Load(ob.ID() + ob.Serial())
+	to, ok := v.(*triggerObject)
+	if !ok {
+		return false
+	}
+	to.time += delta
+	if to.time < t.StayInterval() {
+		return false
+	}
+	to.time = 0
+	t.apply(ob, t.stayMods, t.stayEffects)
+	return true
+}
+
+// meetReqs checks if specified object meets trigger
+// requirements.
+func (t *Trigger) meetReqs(ob Object) bool {
+	if len(t.Requirements()) < 1 {
+		return true
+	}
+	rt, ok := ob.(req.RequirementsTarget)
+	if !ok {
+		return false
+	}
+	return rt.MeetReqs(t.Requirements()...)
+}
+
+// apply applies specified modifiers and effects on
+// specified object.
+func (t *Trigger) apply(ob Object, mods []effect.Modifier, effects []res.EffectData) {
+	ob.TakeModifiers(nil, mods...)
+	for _, ed := range effects {
+		ob.TakeEffect(effect.New(ed))
+	}
+}
+
+// triggerEffects returns effects data for specified
+// trigger effects.
+func triggerEffects(data []res.UseActionEffectData) (effects []res.EffectData) {
+	for _, ed := range data {
+		data := res.Effect(ed.ID)
+		if data == nil {
+			log.Err.Printf("area trigger: effect not found: %s", ed.ID)
+			continue
+		}
+		effects = append(effects, *data)
+	}
+	return
+}
+
+// triggerEffectsData returns trigger effects data for
+// specified effects.
+func triggerEffectsData(effects []res.EffectData) (data []res.UseActionEffectData) {
+	for _, ed := range effects {
+		data = append(data, res.UseActionEffectData{ed.ID})
+	}
+	return
+}
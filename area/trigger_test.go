@@ -0,0 +1,120 @@
+/*
+ * trigger_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/flag"
+)
+
+var triggerData = res.TriggerData{
+	ID:     "trigger",
+	Region: res.RegionData{X: 0, Y: 0, Width: 10, Height: 10},
+	EnterMods: res.ModifiersData{
+		FlagMods: []res.FlagModData{{ID: "flagEnter"}},
+	},
+	LeaveMods: res.ModifiersData{
+		FlagMods: []res.FlagModData{{ID: "flagEnter", Off: true}},
+	},
+	StayMods: res.ModifiersData{
+		HealthMods: []res.HealthModData{{Min: -1, Max: -1}},
+	},
+	StayInterval: 100,
+}
+
+// TestTriggerModifiers tests applying trigger modifiers
+// on objects entering, leaving and staying inside the
+// trigger region.
+func TestTriggerModifiers(t *testing.T) {
+	// Create area & object
+	area := New(res.AreaData{ID: "area", Triggers: []res.TriggerData{triggerData}})
+	ob := character.New(charData)
+	ob.SetPosition(5, 5)
+	area.AddObject(ob)
+	// Test
+	area.Update(1)
+	if !ob.HasFlag(flag.Flag("flagEnter")) {
+		t.Errorf("Enter modifiers not applied")
+	}
+	hp := ob.Health()
+	area.Update(100)
+	if ob.Health() != hp-1 {
+		t.Errorf("Stay modifiers not applied: %d != %d", ob.Health(), hp-1)
+	}
+	ob.SetPosition(20, 20)
+	area.Update(1)
+	if ob.HasFlag(flag.Flag("flagEnter")) {
+		t.Errorf("Leave modifiers not applied")
+	}
+}
+
+// TestTriggerRequirements tests entering trigger region
+// with requirements.
+func TestTriggerRequirements(t *testing.T) {
+	// Create area & object
+	data := triggerData
+	data.Requirements = res.ReqsData{FlagReqs: []res.IDReqData{{ID: "flagReq"}}}
+	area := New(res.AreaData{ID: "area", Triggers: []res.TriggerData{data}})
+	ob := character.New(charData)
+	ob.SetPosition(5, 5)
+	area.AddObject(ob)
+	// Test
+	area.Update(1)
+	if ob.HasFlag(flag.Flag("flagEnter")) {
+		t.Errorf("Enter modifiers applied without meeting requirements")
+	}
+	ob.AddFlag(flag.Flag("flagReq"))
+	area.Update(1)
+	if !ob.HasFlag(flag.Flag("flagEnter")) {
+		t.Errorf("Enter modifiers not applied")
+	}
+}
+
+// TestTriggerOnce tests trigger that should fire only
+// once.
+func TestTriggerOnce(t *testing.T) {
+	// Create area & object
+	data := triggerData
+	data.Once = true
+	area := New(res.AreaData{ID: "area", Triggers: []res.TriggerData{data}})
+	ob := character.New(charData)
+	ob.SetPosition(5, 5)
+	area.AddObject(ob)
+	// Test
+	area.Update(1)
+	ob.SetPosition(20, 20)
+	area.Update(1)
+	ob.SetPosition(5, 5)
+	area.Update(1)
+	if ob.HasFlag(flag.Flag("flagEnter")) {
+		t.Errorf("Trigger fired more than once")
+	}
+	area = New(area.Data())
+	if area.Triggers()[0].Active() {
+		t.Errorf("Trigger should not be active after restore")
+	}
+}
@@ -1,7 +1,7 @@
 /*
  * weather.go
  *
- * Copyright 2021-2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2021-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -24,8 +24,12 @@
 package area
 
 import (
-	"time"
+	"sync"
 
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/log"
 	"github.com/isangeles/flame/rng"
 )
 
@@ -33,47 +37,261 @@ import (
 type Weather struct {
 	area       *Area
 	Conditions Conditions
-	lastChange time.Time
+	time       int64
+	season     string
+	table      []*weatherConditions
+	tableData  []res.WeatherConditionsData
+	applied    *weatherConditions
+	affected   *sync.Map
 }
 
 // Type for area weather conditions.
 type Conditions string
 
+// Struct for weather conditions from the weather table.
+type weatherConditions struct {
+	data    res.WeatherConditionsData
+	mods    []effect.Modifier
+	effects []res.EffectData
+}
+
 const (
 	Sunny           Conditions = Conditions("weatherSunny")
 	Rain                       = Conditions("weatherRain")
-	conditionsTimer            = 60.0 // minutes
+	conditionsTimer            = 3600000 // milliseconds
 )
 
+// Weather table used for areas without weather table.
+var defaultWeatherTable = []res.WeatherConditionsData{
+	{ID: string(Sunny), Weight: 3},
+	{ID: string(Rain), Weight: 1},
+}
+
 // newWeather creates new area weather.
 func newWeather(area *Area) *Weather {
-	w := Weather{area: area}
+	w := Weather{area: area, affected: new(sync.Map)}
+	w.SetTable(defaultWeatherTable)
 	return &w
 }
 
 // update updates weather.
-func (w *Weather) update() {
-	if len(w.Conditions) < 1 {
+func (w *Weather) update(delta int64) {
+	if season := w.area.Clock().Season(); len(season) > 0 {
+		w.season = season
+	}
+	w.time -= delta
+	if len(w.Conditions) < 1 || w.time <= 0 {
 		w.changeWeather()
 	}
-	if w.lastChange.IsZero() {
-		w.lastChange = w.area.Time
-		return
+	w.updateObjects()
+}
+
+// Time returns time in milliseconds left to the
+// change of current weather conditions.
+func (w *Weather) Time() int64 {
+	return w.time
+}
+
+// SetTime sets time in milliseconds left to the
+// change of current weather conditions.
+func (w *Weather) SetTime(time int64) {
+	w.time = time
+}
+
+// Season returns ID of the current season.
+func (w *Weather) Season() string {
+	return w.season
+}
+
+// SetSeason sets current season.
+// Only weather conditions available in the
+// current season are selected on weather change.
+// Season of the area clock calendar, if set, overwrites
+// season set with this function.
+func (w *Weather) SetSeason(season string) {
+	w.season = season
+}
+
+// SetTable sets weather table with conditions
+// available for the area.
+// Empty table sets the default weather table.
+func (w *Weather) SetTable(data []res.WeatherConditionsData) {
+	w.tableData = data
+	if len(data) < 1 {
+		data = defaultWeatherTable
 	}
-	if w.area.Time.Sub(w.lastChange).Minutes() < conditionsTimer {
-		return
+	w.table = nil
+	for _, cd := range data {
+		c := weatherConditions{
+			data: cd,
+			mods: effect.NewModifiers(cd.Mods),
+		}
+		for _, ed := range cd.Effects {
+			data := res.Effect(ed.ID)
+			if data == nil {
+				log.Err.Printf("area: %s: weather effect not found: %s",
+					w.area.ID(), ed.ID)
+				continue
+			}
+			c.effects = append(c.effects, *data)
+		}
+		w.table = append(w.table, &c)
 	}
-	w.changeWeather()
+}
+
+// TableData returns data of the weather table, or nil
+// if weather uses the default weather table.
+func (w *Weather) TableData() []res.WeatherConditionsData {
+	return w.tableData
 }
 
 // changeWeather changes current weather conditions.
+// New conditions are rolled from the weather table
+// or transitions of the current conditions, depending
+// on the conditions weights.
 func (w *Weather) changeWeather() {
-	roll := rng.RollInt(1, 4)
-	switch roll {
-	case 1, 2:
-		w.Conditions = Sunny
-	case 3:
-		w.Conditions = Rain
-	}
-	w.lastChange = w.area.Time
+	weights := make(map[*weatherConditions]int)
+	if current := w.conditions(w.Conditions); current != nil {
+		for _, t := range current.data.Transitions {
+			c := w.conditions(Conditions(t.ID))
+			if c != nil && c.available(w.Season()) {
+				weights[c] = t.Weight
+			}
+		}
+	}
+	if len(weights) < 1 {
+		for _, c := range w.table {
+			if c.available(w.Season()) {
+				weights[c] = c.data.Weight
+			}
+		}
+	}
+	total := 0
+	for _, c := range w.table {
+		total += weights[c]
+	}
+	if total < 1 {
+		w.time = conditionsTimer
+		return
+	}
+	roll := rng.RollInt(1, total)
+	for _, c := range w.table {
+		roll -= weights[c]
+		if roll > 0 {
+			continue
+		}
+		w.Conditions = Conditions(c.data.ID)
+		w.time = c.duration()
+		break
+	}
+}
+
+// updateObjects applies modifiers and effects of the current
+// weather conditions on characters outdoors, and removes
+// modifiers from characters that are not outdoors anymore.
+func (w *Weather) updateObjects() {
+	if w.applied != nil && w.applied.data.ID != string(w.Conditions) {
+		w.removeConditions()
+	}
+	w.applied = w.conditions(w.Conditions)
+	if w.applied == nil {
+		return
+	}
+	for _, ob := range w.area.Objects() {
+		char, ok := ob.(*character.Character)
+		if !ok {
+			continue
+		}
+		_, affected := w.affected.Load(char.ID() + char.Serial())
+		if !w.area.outdoor(char) {
+			if affected {
+				char.RemoveModifiers(nil, w.applied.mods...)
+				w.affected.Delete(char.ID() + char.Serial())
+			}
+			continue
+		}
+		if !affected {
+			char.TakeModifiers(nil, w.applied.mods...)
+			w.affected.Store(char.ID()+char.Serial(), char)
+		}
+		for _, ed := range w.applied.effects {
+			if !hasEffect(char, ed.ID) {
+				char.TakeEffect(effect.New(ed))
+			}
+		}
+	}
+}
+
+// removeConditions removes modifiers of the applied weather
+// conditions from all affected characters.
+func (w *Weather) removeConditions() {
+	removeMods := func(k, v interface{}) bool {
+		if char, ok := v.(*character.Character); ok {
+			char.RemoveModifiers(nil, w.applied.mods...)
+		}
+		w.affected.Delete(k)
+		return true
+	}
+	w.affected.Range(removeMods)
+	w.applied = nil
+}
+
+// removeObject removes modifiers of the applied weather
+// conditions from specified object.
+func (w *Weather) removeObject(ob Object) {
+	if _, affected := w.affected.Load(ob.ID() + ob.Serial()); !affected {
+		return
+	}
+	if w.applied != nil {
+		ob.RemoveModifiers(nil, w.applied.mods...)
+	}
+	w.affected.Delete(ob.ID() + ob.Serial())
+}
+
+// conditions returns conditions with specified ID
+// from the weather table.
+func (w *Weather) conditions(id Conditions) *weatherConditions {
+	for _, c := range w.table {
+		if c.data.ID == string(id) {
+			return c
+		}
+	}
+	return nil
+}
+
+// available checks if conditions are available in
+// specified season.
+func (wc *weatherConditions) available(season string) bool {
+	if len(wc.data.Seasons) < 1 {
+		return true
+	}
+	for _, s := range wc.data.Seasons {
+		if s.ID == season {
+			return true
+		}
+	}
+	return false
+}
+
+// duration returns random duration of the conditions
+// in milliseconds.
+func (wc *weatherConditions) duration() int64 {
+	if wc.data.MaxDuration < 1 {
+		return conditionsTimer
+	}
+	if wc.data.MinDuration >= wc.data.MaxDuration {
+		return wc.data.MaxDuration
+	}
+	return int64(rng.RollInt(int(wc.data.MinDuration), int(wc.data.MaxDuration)))
+}
+
+// hasEffect checks if specified object has effect with
+// specified ID.
+func hasEffect(ob Object, id string) bool {
+	for _, e := range ob.Effects() {
+		if e.ID() == id {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,186 @@
+/*
+ * weather_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package area
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+)
+
+var weatherTable = []res.WeatherConditionsData{
+	{
+		ID:          "weatherSnow",
+		Weight:      1,
+		MinDuration: 100,
+		MaxDuration: 100,
+		Seasons:     []res.WeatherSeasonData{{ID: "winter"}},
+		Transitions: []res.WeatherTransitionData{{ID: "weatherFog", Weight: 1}},
+		Mods: res.ModifiersData{
+			SightMods: []res.ValueModData{{Value: -100}},
+		},
+	},
+	{
+		ID:          "weatherFog",
+		Weight:      1,
+		MinDuration: 100,
+		MaxDuration: 100,
+		Seasons:     []res.WeatherSeasonData{{ID: "winter"}, {ID: "summer"}},
+	},
+}
+
+// TestWeatherSeasons tests selecting weather conditions
+// available in the current season.
+func TestWeatherSeasons(t *testing.T) {
+	// Create area
+	area := New(res.AreaData{ID: "area", Season: "summer", WeatherTable: weatherTable})
+	// Test
+	area.Update(1)
+	if area.Weather().Conditions != "weatherFog" {
+		t.Errorf("Invalid weather conditions: %s != weatherFog",
+			area.Weather().Conditions)
+	}
+	if area.Weather().Time() != 100 {
+		t.Errorf("Invalid weather time: %d != 100", area.Weather().Time())
+	}
+}
+
+// TestWeatherTransitions tests changing weather conditions
+// with conditions transitions.
+func TestWeatherTransitions(t *testing.T) {
+	// Create area
+	data := res.AreaData{
+		ID:           "area",
+		Season:       "winter",
+		Weather:      "weatherSnow",
+		WeatherTime:  10,
+		WeatherTable: weatherTable,
+	}
+	area := New(data)
+	// Test
+	area.Update(5)
+	if area.Weather().Conditions != "weatherSnow" {
+		t.Errorf("Weather conditions changed before time")
+	}
+	area.Update(5)
+	if area.Weather().Conditions != "weatherFog" {
+		t.Errorf("Invalid weather conditions: %s != weatherFog",
+			area.Weather().Conditions)
+	}
+}
+
+// TestWeatherInitTime tests keeping initial weather
+// conditions set without weather time.
+func TestWeatherInitTime(t *testing.T) {
+	// Create area
+	area := New(res.AreaData{ID: "area", Weather: "rain"})
+	// Test
+	area.Update(1)
+	if area.Weather().Conditions != "rain" {
+		t.Errorf("Invalid weather conditions: %s != rain", area.Weather().Conditions)
+	}
+	if area.Weather().Time() != conditionsTimer-1 {
+		t.Errorf("Invalid weather time: %d != %d", area.Weather().Time(),
+			conditionsTimer-1)
+	}
+	area = New(res.AreaData{ID: "area", Weather: "weatherSnow",
+		Season: "winter", WeatherTable: weatherTable})
+	area.Update(1)
+	if area.Weather().Conditions != "weatherSnow" {
+		t.Errorf("Invalid weather conditions: %s != weatherSnow",
+			area.Weather().Conditions)
+	}
+	if area.Weather().Time() != 99 {
+		t.Errorf("Invalid weather time: %d != 99", area.Weather().Time())
+	}
+}
+
+// TestWeatherModifiers tests applying weather modifiers
+// on characters outdoors.
+func TestWeatherModifiers(t *testing.T) {
+	// Create area & character
+	data := res.AreaData{
+		ID:           "area",
+		Season:       "winter",
+		Weather:      "weatherSnow",
+		WeatherTime:  10,
+		WeatherTable: weatherTable,
+	}
+	area := New(data)
+	char := character.New(charData)
+	area.AddObject(char)
+	sight := char.SightRange()
+	// Test
+	area.Update(1)
+	if char.SightRange() != sight-100 {
+		t.Errorf("Invalid sight range: %f != %f", char.SightRange(), sight-100)
+	}
+	area.Update(1)
+	if char.SightRange() != sight-100 {
+		t.Errorf("Weather modifiers applied twice: %f != %f", char.SightRange(),
+			sight-100)
+	}
+	area.Update(10)
+	if char.SightRange() != sight {
+		t.Errorf("Weather modifiers not removed: %f != %f", char.SightRange(), sight)
+	}
+	area.Weather().Conditions = "weatherSnow"
+	area.Update(1)
+	area.RemoveObject(char)
+	if char.SightRange() != sight {
+		t.Errorf("Weather modifiers not removed after leaving area: %f != %f",
+			char.SightRange(), sight)
+	}
+}
+
+// TestWeatherData tests saving weather state in the
+// area data.
+func TestWeatherData(t *testing.T) {
+	// Create area
+	data := res.AreaData{
+		ID:           "area",
+		Season:       "winter",
+		Weather:      "weatherSnow",
+		WeatherTime:  10,
+		WeatherTable: weatherTable,
+	}
+	area := New(data)
+	// Test
+	area.Update(5)
+	data = area.Data()
+	if data.Weather != "weatherSnow" {
+		t.Errorf("Invalid weather conditions: %s != weatherSnow", data.Weather)
+	}
+	if data.WeatherTime != 5 {
+		t.Errorf("Invalid weather time: %d != 5", data.WeatherTime)
+	}
+	if data.Season != "winter" {
+		t.Errorf("Invalid season: %s != winter", data.Season)
+	}
+	if len(data.WeatherTable) != len(weatherTable) {
+		t.Errorf("Invalid weather table size: %d != %d", len(data.WeatherTable),
+			len(weatherTable))
+	}
+}
@@ -1,7 +1,7 @@
 /*
  * chapter.go
  *
- * Copyright 2018-2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2018-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -26,10 +26,14 @@ package flame
 import (
 	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/isangeles/flame/area"
 	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/clock"
 	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/event"
+	"github.com/isangeles/flame/flag"
 	"github.com/isangeles/flame/log"
 )
 
@@ -39,6 +43,9 @@ type Chapter struct {
 	conf        *ChapterConfig
 	mod         *Module
 	areas       map[string]*area.Area
+	clock       *clock.Clock
+	events      []*event.Event
+	flags       *sync.Map
 }
 
 // NewChapter creates new module chapter.
@@ -47,16 +54,22 @@ func NewChapter(mod *Module, data res.ChapterData) *Chapter {
 	c.mod = mod
 	c.conf = new(ChapterConfig)
 	c.areas = make(map[string]*area.Area)
+	c.clock = clock.New(res.ClockData{})
+	c.flags = new(sync.Map)
 	c.Apply(data)
 	return c
 }
 
 // Update updates chapter.
 func (c *Chapter) Update(delta int64) {
+	c.clock.Update(delta)
 	for _, a := range c.areas {
 		a.Update(delta)
 	}
 	c.updateObjectsArea()
+	for _, e := range c.events {
+		e.Update(c)
+	}
 }
 
 // ID returns chapter ID.
@@ -92,12 +105,66 @@ func (c *Chapter) Areas() (areas []*area.Area) {
 
 // AddAreas adds specified areas to loaded
 // areas list.
+// Chapter clock is set as clock of all added areas
+// and chapter is set as the world of all added areas.
 func (c *Chapter) AddAreas(areas ...*area.Area) {
 	for _, a := range areas {
+		a.SetClock(c.Clock())
+		a.SetWorld(c)
 		c.areas[a.ID()] = a
 	}
 }
 
+// Clock returns chapter world clock.
+func (c *Chapter) Clock() *clock.Clock {
+	return c.clock
+}
+
+// Events returns all chapter world events.
+func (c *Chapter) Events() []*event.Event {
+	return c.events
+}
+
+// Event returns chapter world event with specified ID,
+// or nil if there is no such event.
+func (c *Chapter) Event(id string) *event.Event {
+	for _, e := range c.events {
+		if e.ID() == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// AddFlag adds specified world flag to the chapter.
+func (c *Chapter) AddFlag(f flag.Flag) {
+	c.flags.Store(f.ID(), f)
+}
+
+// RemoveFlag removes specified world flag from the chapter.
+func (c *Chapter) RemoveFlag(f flag.Flag) {
+	c.flags.Delete(f.ID())
+}
+
+// HasFlag checks if chapter has specified world flag.
+func (c *Chapter) HasFlag(f flag.Flag) bool {
+	_, ok := c.flags.Load(f.ID())
+	return ok
+}
+
+// Flags returns all chapter world flags.
+func (c *Chapter) Flags() (flags []flag.Flag) {
+	addFlag := func(k, v interface{}) bool {
+		f, ok := v.(flag.Flag)
+		if ok {
+			flags = append(flags, f)
+		}
+		return true
+	}
+	c.flags.Range(addFlag)
+	return
+}
+
 // Conf returns chapter configuration.
 func (c *Chapter) Conf() *ChapterConfig {
 	return c.conf
@@ -171,6 +238,16 @@ func (c *Chapter) ObjectArea(ob area.Object) *area.Area {
 	return nil
 }
 
+// GrantStartAttrs adds attribute points specified in
+// the chapter configuration to the attribute points of
+// specified newly created character.
+// Start attribute points are not granted automatically,
+// this function should be called once for each character
+// created by the player.
+func (c *Chapter) GrantStartAttrs(char *character.Character) {
+	char.SetAttributePoints(char.AttributePoints() + c.Conf().StartAttrs)
+}
+
 // Apply applies specified data on the chapter.
 // Also, adds chapter resources to resources
 // base in res package.
@@ -196,6 +273,11 @@ func (c *Chapter) Apply(data res.ChapterData) {
 	}
 	c.conf.StartItems = data.Config["start-items"]
 	c.conf.StartSkills = data.Config["start-skills"]
+	c.clock.Apply(clockData(data.Config))
+	c.flags = new(sync.Map)
+	for _, id := range data.Config["flags"] {
+		c.AddFlag(flag.Flag(id))
+	}
 	c.res = &data.Resources
 	res.Add(*c.res)
 	for _, ad := range data.Resources.Areas {
@@ -207,6 +289,14 @@ func (c *Chapter) Apply(data res.ChapterData) {
 		}
 		a.Apply(ad)
 	}
+	for _, ed := range data.Events {
+		e := c.Event(ed.ID)
+		if e == nil {
+			c.events = append(c.events, event.New(ed))
+			continue
+		}
+		e.Apply(ed)
+	}
 }
 
 // Data creates data resource for chapter.
@@ -222,6 +312,12 @@ func (c *Chapter) Data() res.ChapterData {
 	data.Config["start-skills"] = c.Conf().StartSkills
 	data.Config["start-attrs"] = []string{fmt.Sprintf("%d", c.Conf().StartAttrs)}
 	data.Config["start-level"] = []string{fmt.Sprintf("%d", c.Conf().StartLevel)}
+	for k, v := range clockConfig(c.Clock().Data()) {
+		data.Config[k] = v
+	}
+	for _, f := range c.Flags() {
+		data.Config["flags"] = append(data.Config["flags"], f.ID())
+	}
 	data.Resources = *c.res
 	// Remove old characters from resources, besides basic ones.
 	data.Resources.Characters = make([]res.CharacterData, 0)
@@ -237,15 +333,38 @@ func (c *Chapter) Data() res.ChapterData {
 	for _, a := range c.Areas() {
 		data.Resources.Areas = append(data.Resources.Areas, a.Data())
 	}
+	for _, e := range c.Events() {
+		data.Events = append(data.Events, e.Data())
+	}
 	return data
 }
 
+// enterPortal moves specified character from specified area
+// to the destination of specified portal.
+func (c *Chapter) enterPortal(a *area.Area, char *character.Character, p *area.Portal) {
+	x, y := p.DestPoint()
+	char.SetPosition(x, y)
+	char.SetDestPoint(x, y)
+	if len(p.DestArea()) < 1 || p.DestArea() == a.ID() {
+		// Re-add to update portals state for the new position.
+		a.AddObject(char)
+		return
+	}
+	char.SetAreaID(p.DestArea())
+}
+
 // updateObjectsArea checks and moves game objects to
 // proper areas, if needed.
 func (c *Chapter) updateObjectsArea() {
 	for _, char := range c.Characters() {
 		currentArea := c.ObjectArea(char)
-		if currentArea != nil && currentArea.ID() == char.AreaID() {
+		if currentArea == nil {
+			continue
+		}
+		if p := currentArea.EnteredPortal(char); p != nil {
+			c.enterPortal(currentArea, char, p)
+		}
+		if currentArea.ID() == char.AreaID() {
 			continue
 		}
 		var newArea *area.Area
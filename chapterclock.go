@@ -0,0 +1,91 @@
+/*
+ * chapterclock.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package flame
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/isangeles/flame/data/res"
+)
+
+// clockData creates clock data from specified chapter
+// config values.
+func clockData(config map[string][]string) res.ClockData {
+	data := res.ClockData{}
+	if len(config["time"]) > 0 {
+		data.Time, _ = strconv.ParseInt(config["time"][0], 10, 64)
+	}
+	if len(config["time-scale"]) > 0 {
+		data.Scale, _ = strconv.ParseFloat(config["time-scale"][0], 64)
+	}
+	for _, d := range config["week-days"] {
+		data.WeekDays = append(data.WeekDays, res.CalendarDayData{ID: d})
+	}
+	for i, m := range config["months"] {
+		month := res.CalendarMonthData{ID: m}
+		if len(config["month-days"]) > i {
+			month.Days, _ = strconv.Atoi(config["month-days"][i])
+		}
+		if len(config["month-seasons"]) > i {
+			month.Season = config["month-seasons"][i]
+		}
+		data.Months = append(data.Months, month)
+	}
+	for i, p := range config["day-phases"] {
+		phase := res.DayPhaseData{ID: p}
+		if len(config["day-phases-start"]) > i {
+			phase.Start, _ = strconv.Atoi(config["day-phases-start"][i])
+		}
+		if len(config["day-phases-sight"]) > i {
+			phase.Sight, _ = strconv.ParseFloat(config["day-phases-sight"][i], 64)
+		}
+		data.DayPhases = append(data.DayPhases, phase)
+	}
+	return data
+}
+
+// clockConfig creates chapter config values for
+// specified clock data.
+func clockConfig(data res.ClockData) map[string][]string {
+	config := make(map[string][]string)
+	config["time"] = []string{fmt.Sprintf("%d", data.Time)}
+	config["time-scale"] = []string{fmt.Sprintf("%f", data.Scale)}
+	for _, d := range data.WeekDays {
+		config["week-days"] = append(config["week-days"], d.ID)
+	}
+	for _, m := range data.Months {
+		config["months"] = append(config["months"], m.ID)
+		config["month-days"] = append(config["month-days"], fmt.Sprintf("%d", m.Days))
+		config["month-seasons"] = append(config["month-seasons"], m.Season)
+	}
+	for _, p := range data.DayPhases {
+		config["day-phases"] = append(config["day-phases"], p.ID)
+		config["day-phases-start"] = append(config["day-phases-start"],
+			fmt.Sprintf("%d", p.Start))
+		config["day-phases-sight"] = append(config["day-phases-sight"],
+			fmt.Sprintf("%f", p.Sight))
+	}
+	return config
+}
@@ -25,6 +25,7 @@ package character
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/isangeles/flame/data/res"
 )
@@ -37,12 +38,24 @@ const (
 	BaseMana       = 10
 )
 
+// Type for attribute IDs.
+type Attribute string
+
+const (
+	Strength     = Attribute("str")
+	Constitution = Attribute("con")
+	Dexterity    = Attribute("dex")
+	Wisdom       = Attribute("wis")
+	Intelligence = Attribute("int")
+)
+
 // Attributes struct represents game character attributes: strenght,
 // constitution, dexterity, wisdom, intelligence.
 type Attributes struct {
 	Str, Con, Dex, Wis, Int int
 	VisibilityMod           int
 	MoveMod                 int64
+	SightMod                float64
 }
 
 // Lift returns maximal size of inventory based on
@@ -54,7 +67,7 @@ func (a *Attributes) Lift() int {
 // Sight returns maximal sight range based on
 // attributes.
 func (a *Attributes) Sight() float64 {
-	return BaseSight // * float64(1 + a.Wis)
+	return math.Max(0, BaseSight+a.SightMod) // * float64(1 + a.Wis)
 }
 
 // Visibility return current visibility value.
@@ -62,6 +75,12 @@ func (a *Attributes) Visibility() int {
 	return BaseVisibility + a.VisibilityMod
 }
 
+// Lockpicking returns lockpicking value based on
+// attributes, equal to dexterity.
+func (a *Attributes) Lockpicking() int {
+	return a.Dex
+}
+
 // Health returns maximal health based on
 // attributes.
 func (a *Attributes) Health() int {
@@ -88,6 +107,25 @@ func (a *Attributes) String() string {
 		a.Str, a.Con, a.Dex, a.Wis, a.Int)
 }
 
+// Value returns pointer to the value of specified attribute
+// or nil if there is no such attribute.
+func (a *Attributes) Value(attr Attribute) *int {
+	switch attr {
+	case Strength:
+		return &a.Str
+	case Constitution:
+		return &a.Con
+	case Dexterity:
+		return &a.Dex
+	case Wisdom:
+		return &a.Wis
+	case Intelligence:
+		return &a.Int
+	default:
+		return nil
+	}
+}
+
 // Apply applies specified data on character attributes.
 func (a *Attributes) Apply(data res.AttributesData) {
 	a.Str = data.Str
@@ -30,6 +30,7 @@ import (
 	"math"
 	"sync"
 
+	"github.com/isangeles/flame/clock"
 	"github.com/isangeles/flame/craft"
 	"github.com/isangeles/flame/data/res"
 	"github.com/isangeles/flame/dialog"
@@ -38,6 +39,7 @@ import (
 	"github.com/isangeles/flame/item"
 	"github.com/isangeles/flame/objects"
 	"github.com/isangeles/flame/quest"
+	"github.com/isangeles/flame/req"
 	"github.com/isangeles/flame/serial"
 	"github.com/isangeles/flame/skill"
 	"github.com/isangeles/flame/training"
@@ -54,8 +56,11 @@ type Character struct {
 	live            bool
 	agony           bool
 	openLoot        bool
+	blocking        bool
+	ai              bool
 	sex             Gender
 	race            Race
+	class           Class
 	attitude        Attitude
 	alignment       Alignment
 	guild           Guild
@@ -65,7 +70,11 @@ type Character struct {
 	posX, posY      float64
 	destX, destY    float64
 	defX, defY      float64
+	radius          float64
 	useCooldown     int64 // millis
+	combatTime      int64 // millis
+	memoryTime      int64 // millis
+	helpRange       float64
 	moveCooldown    int64 // millis
 	respawn         int64 // millis
 	despawn         int64 // millis
@@ -80,13 +89,40 @@ type Character struct {
 	effects         *sync.Map
 	skills          *sync.Map
 	memory          *sync.Map
+	reputation      *sync.Map
+	helpCalls       []*TargetMemory
+	threat          *sync.Map
 	dialogs         *sync.Map
 	startedDialogs  *sync.Map
 	flags           *sync.Map
 	trainings       []*training.TrainerTraining
+	schedule        []res.ScheduleEntryData
+	scheduleEntry   string
+	behaviorTree    string
+	treeState       []res.BehaviorStateData
+	tradeReqs       []req.Requirement
+	party           Party
+	owner           res.SerialObjectData
+	summons         []res.SerialObjectData
+	summonQueue     []*Character
+	lifetime        int64 // millis
+	expired         bool
+	command         Command
+	attrPoints      int
+	spentAttrs      Attributes
 	casted          res.CastedObjectData
 	chatLog         *objects.Log
 	onModifierTaken func(m effect.Modifier)
+	onLevelUp       func(level res.LevelData)
+	env             Environment
+}
+
+// Interface for character environment.
+type Environment interface {
+	LineOfSight(x1, y1, x2, y2 float64) bool
+	SightMod(x, y float64) float64
+	Clock() *clock.Clock
+	HasWorldFlag(f flag.Flag) bool
 }
 
 const (
@@ -104,11 +140,14 @@ func New(data res.CharacterData) *Character {
 		effects:        new(sync.Map),
 		skills:         new(sync.Map),
 		memory:         new(sync.Map),
+		reputation:     new(sync.Map),
+		threat:         new(sync.Map),
 		dialogs:        new(sync.Map),
 		startedDialogs: new(sync.Map),
 		flags:          new(sync.Map),
 		chatLog:        objects.NewLog(),
 		race:           NewRace(res.RaceData{}),
+		class:          NewClass(res.ClassData{}),
 	}
 	c.equipment = newEquipment(&c)
 	c.journal = quest.NewJournal(&c)
@@ -149,6 +188,13 @@ func (c *Character) Update(delta int64) {
 	c.Inventory().Update(delta)
 	// Dialogs.
 	c.startedDialogs.Range(c.removeFinishedDialog)
+	// Schedule.
+	c.updateSchedule()
+	// Memory & threat.
+	c.updateMemory(delta)
+	c.updateThreat(delta)
+	// Summons.
+	c.updateSummons(delta)
 	// Skills.
 	for _, s := range c.Skills() {
 		s.Update(delta)
@@ -204,7 +250,8 @@ func (c *Character) Health() int {
 // MaxHealth returns maximal value of
 // health points.
 func (c *Character) MaxHealth() int {
-	return c.attributes.Health() + (BaseHealth * c.Level())
+	health, _ := c.levelBonus()
+	return c.attributes.Health() + health
 }
 
 // Mana returns current value of mana
@@ -216,7 +263,8 @@ func (c *Character) Mana() int {
 // MaxMana returns maximal value of mana
 // points.
 func (c *Character) MaxMana() int {
-	return c.attributes.Mana() + (BaseMana * c.Level() / 2)
+	_, mana := c.levelBonus()
+	return c.attributes.Mana() + mana
 }
 
 // Experience returns current value of experience
@@ -227,7 +275,12 @@ func (c *Character) Experience() int {
 
 // MaxExperience returns maximal value of
 // experience points.
+// Uses experience from the character level data
+// if specified.
 func (c *Character) MaxExperience() int {
+	if data := res.Level(c.Level()); data != nil && data.Exp > 0 {
+		return data.Exp
+	}
 	return baseExp * c.Level()
 }
 
@@ -275,9 +328,12 @@ func (c *Character) SetAttitude(att Attitude) {
 
 // AttitudeFor returns attitude for specified object.
 // For dead objects returns neutral attitude.
+// For owned characters returns attitude of the owner.
 // For memorized targets returns memorized attitude.
 // For not memorized objects from the same guild returns
 // friendly attitude.
+// For not memorized objects with reputation beyond faction
+// thresholds returns faction attitude.
 // For not memorized hostile objects returns hostile attitude.
 // For not memorized neutral objects returns neutral attitude.
 // For other cases returns character attitude.
@@ -286,6 +342,18 @@ func (c *Character) AttitudeFor(o serial.Serialer) Attitude {
 	if ok && !char.Live() {
 		return Neutral
 	}
+	if owner := c.Owner(); owner != nil {
+		if char == owner {
+			return Friendly
+		}
+		return owner.AttitudeFor(o)
+	}
+	if char != nil && char.Owner() != nil {
+		if char.Owner() == c {
+			return Friendly
+		}
+		return c.AttitudeFor(char.Owner())
+	}
 	ob, _ := c.memory.Load(o.ID() + o.Serial())
 	mem, ok := ob.(*TargetMemory)
 	if ok {
@@ -297,6 +365,9 @@ func (c *Character) AttitudeFor(o serial.Serialer) Attitude {
 	if len(char.Guild().ID()) > 0 && char.Guild().ID() == c.Guild().ID() {
 		return Friendly
 	}
+	if att := c.factionAttitude(char); att != Neutral {
+		return att
+	}
 	if char.Attitude() == Neutral {
 		return Neutral
 	}
@@ -347,8 +418,17 @@ func (c *Character) DefaultPosition() (float64, float64) {
 }
 
 // SightRange returns current sight range.
+// Sight range depends on the character environment.
 func (c *Character) SightRange() float64 {
-	return c.attributes.Sight()
+	if c.Environment() == nil {
+		return c.attributes.Sight()
+	}
+	return math.Max(0, c.attributes.Sight()+c.Environment().SightMod(c.posX, c.posY))
+}
+
+// Lockpicking returns current lockpicking value.
+func (c *Character) Lockpicking() int {
+	return c.attributes.Lockpicking()
 }
 
 // Inventory returns character inventory.
@@ -504,8 +584,37 @@ func (c *Character) Moving() bool {
 	return c.Live() && c.MoveCooldown() < 1 && (c.posX != c.destX || c.posY != c.destY)
 }
 
+// CollisionRadius returns radius of the character
+// collision circle.
+func (c *Character) CollisionRadius() float64 {
+	return c.radius
+}
+
+// SetCollisionRadius sets radius of the character
+// collision circle.
+func (c *Character) SetCollisionRadius(radius float64) {
+	c.radius = radius
+}
+
+// Blocking checks if character blocks movement of
+// other objects.
+func (c *Character) Blocking() bool {
+	return c.blocking && c.Live()
+}
+
+// SetBlocking sets whether character should block
+// movement of other objects.
+func (c *Character) SetBlocking(blocking bool) {
+	c.blocking = blocking
+}
+
 // Fighting checks if character is in combat.
+// Character is in combat if its threat table is not empty
+// or it has hostile target in sight.
 func (c *Character) Fighting() bool {
+	if len(c.Threats()) > 0 {
+		return true
+	}
 	if len(c.Targets()) < 1 {
 		return false
 	}
@@ -513,7 +622,7 @@ func (c *Character) Fighting() bool {
 	if c.AttitudeFor(tar) != Hostile {
 		return false
 	}
-	return objects.Range(c, tar) <= c.SightRange()
+	return c.InSight(tar.Position())
 }
 
 // ChatLog returns character speech log channel.
@@ -578,7 +687,7 @@ func (c *Character) Dialog(ob dialog.Talker) (dial *dialog.Dialog) {
 	findDialog = func(k, v interface{}) bool {
 		d, ok := v.(res.DialogData)
 		// TODO: find proper dialog for specified character.
-		if ok {
+		if ok && c.scheduleDialog(d.ID) {
 			dialogData = d
 		}
 		return true
@@ -657,9 +766,34 @@ func (c *Character) HasFlag(flag flag.Flag) bool {
 }
 
 // InSight checks if specified XY position is in sight range
-// of the character.
+// of the character and there is a clear line of sight to this
+// position.
 func (c *Character) InSight(x, y float64) bool {
-	return math.Hypot(c.posX-x, c.posY-y) <= c.SightRange()
+	if math.Hypot(c.posX-x, c.posY-y) > c.SightRange() {
+		return false
+	}
+	return c.LineOfSight(x, y)
+}
+
+// LineOfSight checks if there is a clear line of sight between
+// the character and specified XY position.
+// Always returns true if character has no environment set.
+func (c *Character) LineOfSight(x, y float64) bool {
+	if c.Environment() == nil {
+		return true
+	}
+	return c.Environment().LineOfSight(c.posX, c.posY, x, y)
+}
+
+// Environment returns character environment.
+func (c *Character) Environment() Environment {
+	return c.env
+}
+
+// SetEnvironment sets specified environment as character
+// environment.
+func (c *Character) SetEnvironment(env Environment) {
+	c.env = env
 }
 
 // Journal returns quest journal.
@@ -727,6 +861,39 @@ func (c *Character) Despawn() int64 {
 	return c.despawn
 }
 
+// AI checks if character is controlled by AI.
+func (c *Character) AI() bool {
+	return c.ai
+}
+
+// SetAI sets character control by AI.
+func (c *Character) SetAI(ai bool) {
+	c.ai = ai
+}
+
+// BehaviorTree returns ID of the character behavior tree.
+func (c *Character) BehaviorTree() string {
+	return c.behaviorTree
+}
+
+// SetBehaviorTree sets behavior tree with specified ID
+// as character behavior tree.
+// Resets behavior tree state.
+func (c *Character) SetBehaviorTree(id string) {
+	c.behaviorTree = id
+	c.treeState = nil
+}
+
+// TreeState returns state of the character behavior tree.
+func (c *Character) TreeState() []res.BehaviorStateData {
+	return c.treeState
+}
+
+// SetTreeState sets state of the character behavior tree.
+func (c *Character) SetTreeState(state []res.BehaviorStateData) {
+	c.treeState = state
+}
+
 // Casted returns usable object currently casted by the character.
 func (c *Character) Casted() useaction.Usable {
 	if len(c.casted.ID) < 1 {
@@ -780,13 +947,6 @@ func (c *Character) Casted() useaction.Usable {
 	return nil
 }
 
-// levelup promotes character to next level.
-func (c *Character) levelup() {
-	c.level += 1
-	c.SetHealth(c.MaxHealth())
-	c.SetMana(c.MaxMana())
-}
-
 // agonyHP returns value of health causing
 // agony state.
 func (c *Character) agonyHP() int {
@@ -91,7 +91,7 @@ func TestAttitudeFor(t *testing.T) {
 		t.Errorf("Attitude is not target default attitude: %v != %v", att, tar.Attitude())
 	}
 	// Test memory.
-	tarMem := TargetMemory{tar.ID(), tar.Serial(), Hostile}
+	tarMem := TargetMemory{tar.ID(), tar.Serial(), Hostile, 0, 0}
 	ob.MemorizeTarget(&tarMem)
 	att = ob.AttitudeFor(tar)
 	if att != Hostile {
@@ -108,7 +108,7 @@ func TestAttitudeFor(t *testing.T) {
 	ob.memory.Delete(tar.ID() + tar.Serial())
 	ob.SetAttitude(Hostile)
 	tar.SetAttitude(Hostile)
-	guild := Guild{"test"}
+	guild := NewGuild("test")
 	ob.SetGuild(guild)
 	tar.SetGuild(guild)
 	att = ob.AttitudeFor(tar)
@@ -0,0 +1,192 @@
+/*
+ * class.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/item"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/skill"
+	"github.com/isangeles/flame/training"
+)
+
+// Struct for character class.
+type Class struct {
+	id        string
+	playable  bool
+	growth    res.AttributesData
+	skills    []res.ObjectSkillData
+	items     []res.ClassItemData
+	equipment []item.Slot
+	trainings []res.TrainerTrainingData
+}
+
+// NewClass creates new class.
+func NewClass(data res.ClassData) Class {
+	c := Class{
+		id:        data.ID,
+		playable:  data.Playable,
+		growth:    data.Growth,
+		skills:    data.Skills,
+		items:     data.Items,
+		trainings: data.Trainings,
+	}
+	for _, sd := range data.Equipment {
+		c.equipment = append(c.equipment, item.Slot(sd.ID))
+	}
+	return c
+}
+
+// ID returns class ID.
+func (c Class) ID() string {
+	return c.id
+}
+
+// Playable checks if class is playable.
+func (c Class) Playable() bool {
+	return c.playable
+}
+
+// Growth returns attributes gained by class members
+// on each level.
+func (c Class) Growth() res.AttributesData {
+	return c.growth
+}
+
+// Skills returns class skills.
+func (c Class) Skills() []res.ObjectSkillData {
+	return c.skills
+}
+
+// Items returns class starting items.
+func (c Class) Items() []res.ClassItemData {
+	return c.items
+}
+
+// Equipment returns item slots allowed for class
+// members, empty list means no restrictions.
+func (c Class) Equipment() []item.Slot {
+	return c.equipment
+}
+
+// Trainings returns class trainings.
+func (c Class) Trainings() []res.TrainerTrainingData {
+	return c.trainings
+}
+
+// CanEquip checks if class members can equip
+// specified item.
+func (c Class) CanEquip(it item.Equiper) bool {
+	if len(c.equipment) < 1 {
+		return true
+	}
+	for _, s := range it.Slots() {
+		allowed := false
+		for _, es := range c.equipment {
+			allowed = allowed || s == es
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// Class returns character class.
+func (c *Character) Class() Class {
+	return c.class
+}
+
+// SetClass sets specified class as character class.
+// Adds class starting items to the character inventory
+// if the class was changed and grants class skills and
+// trainings.
+func (c *Character) SetClass(class Class) {
+	changed := c.Class().ID() != class.ID()
+	c.class = class
+	c.addClassObjects()
+	if !changed {
+		return
+	}
+	for _, id := range class.Items() {
+		data := res.Item(id.ID)
+		if data == nil {
+			log.Err.Printf("char: %s %s: class: item data not found: %s", c.ID(),
+				c.Serial(), id.ID)
+			continue
+		}
+		amount := id.Amount
+		if amount < 1 {
+			amount = 1
+		}
+		for i := 0; i < amount; i++ {
+			c.Inventory().AddItem(item.New(data))
+		}
+	}
+}
+
+// addClassObjects adds class skills and trainings
+// to the character.
+func (c *Character) addClassObjects() {
+	for _, sd := range c.Class().Skills() {
+		if _, ok := c.skills.Load(sd.ID); ok {
+			continue
+		}
+		data := res.Skill(sd.ID)
+		if data == nil {
+			log.Err.Printf("char: %s %s: class: skill data not found: %s", c.ID(),
+				c.Serial(), sd.ID)
+			continue
+		}
+		c.AddSkill(skill.New(*data))
+	}
+	for _, td := range c.Class().Trainings() {
+		found := false
+		for _, t := range c.Trainings() {
+			found = found || t.ID() == td.ID
+		}
+		if found {
+			continue
+		}
+		data := res.Training(td.ID)
+		if data == nil {
+			log.Err.Printf("char: %s %s: class: training data not found: %s", c.ID(),
+				c.Serial(), td.ID)
+			continue
+		}
+		t := training.New(*data)
+		c.AddTraining(training.NewTrainerTraining(t, td))
+	}
+}
+
+// addClassGrowth adds class attributes growth to the
+// character attributes.
+func (c *Character) addClassGrowth() {
+	growth := c.Class().Growth()
+	c.Attributes().Str += growth.Str
+	c.Attributes().Con += growth.Con
+	c.Attributes().Dex += growth.Dex
+	c.Attributes().Int += growth.Int
+	c.Attributes().Wis += growth.Wis
+}
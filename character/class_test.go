@@ -0,0 +1,130 @@
+/*
+ * class_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/item"
+	"github.com/isangeles/flame/req"
+)
+
+// TestClass tests character class.
+func TestClass(t *testing.T) {
+	// Create test objects
+	res.Skills = append(res.Skills, res.SkillData{ID: "classSkill"})
+	res.Trainings = append(res.Trainings, res.TrainingData{ID: "classTraining"})
+	res.Armors = append(res.Armors, res.ArmorData{ID: "classArmor",
+		Slots: []res.ItemSlotData{{string(item.Chest)}}})
+	res.Weapons = append(res.Weapons, res.WeaponData{ID: "classWeapon",
+		Slots: []res.ItemSlotData{{string(item.Hand)}}})
+	classData := res.ClassData{
+		ID:        "warrior",
+		Growth:    res.AttributesData{Str: 2, Con: 1},
+		Skills:    []res.ObjectSkillData{{ID: "classSkill"}},
+		Items:     []res.ClassItemData{{ID: "classWeapon", Amount: 2}},
+		Equipment: []res.ItemSlotData{{string(item.Hand)}},
+		Trainings: []res.TrainerTrainingData{{ID: "classTraining"}},
+	}
+	res.Classes = append(res.Classes, classData)
+	defer func() { res.Classes = nil }()
+	char := New(res.CharacterData{ID: "char", Level: 1})
+	// Test
+	char.SetClass(NewClass(classData))
+	if char.Class().ID() != "warrior" {
+		t.Fatalf("Invalid class: %s != warrior", char.Class().ID())
+	}
+	if len(char.Inventory().Items()) != 2 {
+		t.Errorf("Invalid number of start items: %d != 2",
+			len(char.Inventory().Items()))
+	}
+	if len(char.Skills()) != 1 || char.Skills()[0].ID() != "classSkill" {
+		t.Errorf("Class skill not granted")
+	}
+	if len(char.Trainings()) != 1 || char.Trainings()[0].ID() != "classTraining" {
+		t.Errorf("Class training not granted")
+	}
+	armor := item.NewArmor(res.Armors[len(res.Armors)-1])
+	weapon := item.NewWeapon(res.Weapons[len(res.Weapons)-1])
+	if char.Class().CanEquip(armor) {
+		t.Errorf("Class allowed to equip restricted item")
+	}
+	if !char.Class().CanEquip(weapon) {
+		t.Errorf("Class not allowed to equip allowed item")
+	}
+	char.SetClass(NewClass(classData))
+	if len(char.Inventory().Items()) != 2 {
+		t.Errorf("Start items granted for the same class: %d != 2",
+			len(char.Inventory().Items()))
+	}
+	var handSlot, chestSlot *EquipmentSlot
+	for _, s := range char.Equipment().Slots() {
+		switch s.Type() {
+		case item.Hand:
+			handSlot = s
+		case item.Chest:
+			chestSlot = s
+		}
+	}
+	if err := char.Equipment().Equip(armor, chestSlot); err != CLASS_RESTRICTED {
+		t.Errorf("Invalid equip error: %v != %v", err, CLASS_RESTRICTED)
+	}
+	if err := char.Equipment().Equip(weapon, chestSlot); err != INVALID_SLOT {
+		t.Errorf("Invalid equip error: %v != %v", err, INVALID_SLOT)
+	}
+	if err := char.Equipment().Equip(weapon, handSlot); err != nil {
+		t.Errorf("Unable to equip allowed item: %v", err)
+	}
+	char.Inventory().AddItem(weapon)
+	char.Inventory().AddItem(armor)
+	chestSlot.SetItem(armor)
+	classReq := req.NewClass(res.IDReqData{ID: "warrior"})
+	if !char.MeetReqs(classReq) {
+		t.Errorf("Class requirement not meet")
+	}
+	offReq := req.NewClass(res.IDReqData{ID: "warrior", Off: true})
+	if char.MeetReqs(offReq) {
+		t.Errorf("Off class requirement meet")
+	}
+	str, con := char.Attributes().Str, char.Attributes().Con
+	char.SetExperience(char.MaxExperience())
+	char.Update(1)
+	if char.Attributes().Str != str+2 || char.Attributes().Con != con+1 {
+		t.Errorf("Invalid attributes after levelup: %d %d != %d %d",
+			char.Attributes().Str, char.Attributes().Con, str+2, con+1)
+	}
+	data := char.Data()
+	if data.Class != "warrior" {
+		t.Errorf("Invalid class data: %s != warrior", data.Class)
+	}
+	char = New(data)
+	if char.Class().ID() != "warrior" {
+		t.Errorf("Invalid class after apply: %s != warrior", char.Class().ID())
+	}
+	if len(char.Equipment().Items()) != 2 {
+		t.Errorf("Invalid number of equipped items after apply: %d != 2",
+			len(char.Equipment().Items()))
+	}
+}
@@ -32,9 +32,15 @@ import (
 )
 
 // AddKill adds specified kill on character kill list.
+// Kill experience is shared between all party members
+// in the character area.
 func (c *Character) AddKill(kill res.KillData) {
 	c.kills = append(c.kills, kill)
-	c.SetExperience(c.Experience() + kill.Experience)
+	members := c.partyMembers()
+	exp := kill.Experience / len(members)
+	for _, m := range members {
+		m.SetExperience(m.Experience() + exp)
+	}
 }
 
 // Kills returns all character kill records.
@@ -127,8 +133,11 @@ func (c *Character) TakeEffect(e *effect.Effect) {
 	}
 	if e.Hostile() {
 		// Memorize source as hostile
-		mem := TargetMemory{Attitude: Hostile}
+		mem := TargetMemory{Attitude: Hostile, Duration: c.MemoryTime()}
 		mem.TargetID, mem.TargetSerial = e.Source()
 		c.MemorizeTarget(&mem)
+		if c.HelpRange() > 0 {
+			c.helpCalls = append(c.helpCalls, &mem)
+		}
 	}
 }
@@ -1,7 +1,7 @@
 /*
  * data.go
  *
- * Copyright 2020-2025 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2020-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -31,6 +31,7 @@ import (
 	"github.com/isangeles/flame/effect"
 	"github.com/isangeles/flame/flag"
 	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/req"
 	"github.com/isangeles/flame/skill"
 	"github.com/isangeles/flame/training"
 	"github.com/isangeles/flame/useaction"
@@ -42,12 +43,14 @@ func (c *Character) Apply(data res.CharacterData) {
 	c.level = data.Level
 	c.SetSerial(data.Serial)
 	c.SetExperience(data.Exp)
+	c.SetAttributePoints(data.AttrPoints)
 	c.SetPosition(data.PosX, data.PosY)
 	c.SetDefaultPosition(data.DefX, data.DefY)
 	c.SetDestPoint(data.DestX, data.DestY)
 	c.SetGender(Gender(data.Sex))
 	c.SetAlignment(Alignment(data.Alignment))
 	c.Attributes().Apply(data.Attributes)
+	c.spentAttrs.Apply(data.SpentAttrs)
 	c.Inventory().Apply(data.Inventory)
 	c.Equipment().Apply(data.Equipment)
 	c.Journal().Apply(data.QuestLog)
@@ -62,6 +65,12 @@ func (c *Character) Apply(data res.CharacterData) {
 	c.targets = data.Targets
 	c.kills = data.Kills
 	c.openLoot = data.OpenLoot
+	c.radius = data.Radius
+	c.blocking = !data.NonBlocking
+	c.schedule = data.Schedule
+	c.scheduleEntry = data.ScheduleEntry
+	c.behaviorTree = data.BehaviorTree
+	c.treeState = data.TreeState
 	if useaction.HasData(data.Action) {
 		c.action = useaction.New(data.Action)
 	}
@@ -82,6 +91,13 @@ func (c *Character) Apply(data res.CharacterData) {
 	if raceData != nil && c.Race().ID() != raceData.ID {
 		c.race = NewRace(*raceData)
 	}
+	// Class.
+	classData := res.Class(data.Class)
+	if classData == nil {
+		c.class = Class{}
+	} else if c.Class().ID() != classData.ID {
+		c.class = NewClass(*classData)
+	}
 	// Clear old data.
 	c.clearOldObjects(data)
 	// Add flags.
@@ -202,6 +218,8 @@ func (c *Character) Apply(data res.CharacterData) {
 		trainerTraining := training.NewTrainerTraining(t, charTrainingData)
 		c.trainings = append(c.trainings, trainerTraining)
 	}
+	// Add class skills and trainings.
+	c.addClassObjects()
 	// Memory.
 	for _, memData := range data.Memory {
 		att := Attitude(memData.Attitude)
@@ -209,9 +227,35 @@ func (c *Character) Apply(data res.CharacterData) {
 			TargetID:     memData.ObjectID,
 			TargetSerial: memData.ObjectSerial,
 			Attitude:     att,
+			Time:         memData.Time,
+			Duration:     memData.Duration,
 		}
 		c.MemorizeTarget(&mem)
 	}
+	// Threat.
+	c.ClearThreat()
+	for _, td := range data.Threat {
+		t := Threat{
+			TargetID:     td.ObjectID,
+			TargetSerial: td.ObjectSerial,
+			Value:        td.Value,
+		}
+		c.threat.Store(t.TargetID+t.TargetSerial, &t)
+	}
+	c.combatTime = data.CombatTime
+	c.memoryTime = data.MemoryTime
+	c.helpRange = data.HelpRange
+	// Reputation.
+	c.reputation = new(sync.Map)
+	for _, rd := range data.Reputation {
+		c.SetReputation(rd.Faction, rd.Value)
+	}
+	c.tradeReqs = req.NewRequirements(data.TradeReqs)
+	// Summons.
+	c.owner = data.Owner
+	c.summons = data.Summons
+	c.lifetime = data.Lifetime
+	c.command = Command(data.Command)
 }
 
 // Data creates data resource struct for character.
@@ -227,7 +271,9 @@ func (c *Character) Data() res.CharacterData {
 		HP:           c.Health(),
 		Mana:         c.Mana(),
 		Exp:          c.Experience(),
+		AttrPoints:   c.AttributePoints(),
 		Attributes:   c.Attributes().Data(),
+		SpentAttrs:   c.spentAttrs.Data(),
 		Inventory:    c.Inventory().Data(),
 		Equipment:    c.Equipment().Data(),
 		QuestLog:     c.Journal().Data(),
@@ -242,8 +288,24 @@ func (c *Character) Data() res.CharacterData {
 		Chapter:      c.ChapterID(),
 		UseCooldown:  c.useCooldown,
 		MoveCooldown: c.moveCooldown,
+		Radius:       c.radius,
+		NonBlocking:  !c.blocking,
+		Schedule:     c.schedule,
+		BehaviorTree: c.behaviorTree,
+		CombatTime:   c.combatTime,
+		MemoryTime:   c.memoryTime,
+		HelpRange:    c.helpRange,
+		Reputation:   c.Reputations(),
+		TradeReqs:    req.RequirementsData(c.tradeReqs...),
+		Owner:        c.owner,
+		Summons:      c.summons,
+		Lifetime:     c.lifetime,
+		Command:      string(c.command),
+		TreeState:    c.treeState,
 	}
 	data.Race = c.Race().ID()
+	data.Class = c.Class().ID()
+	data.ScheduleEntry = c.scheduleEntry
 	if c.UseAction() != nil {
 		data.Action = c.UseAction().Data()
 	}
@@ -276,9 +338,19 @@ func (c *Character) Data() res.CharacterData {
 			ObjectID:     m.TargetID,
 			ObjectSerial: m.TargetSerial,
 			Attitude:     string(m.Attitude),
+			Time:         m.Time,
+			Duration:     m.Duration,
 		}
 		data.Memory = append(data.Memory, memData)
 	}
+	for _, t := range c.Threats() {
+		threatData := res.ThreatData{
+			ObjectID:     t.TargetID,
+			ObjectSerial: t.TargetSerial,
+			Value:        t.Value,
+		}
+		data.Threat = append(data.Threat, threatData)
+	}
 	for _, d := range c.Dialogs() {
 		dialogData := res.ObjectDialogData{
 			ID: d.ID,
@@ -24,6 +24,8 @@
 package character
 
 import (
+	"errors"
+
 	"github.com/isangeles/flame/data/res"
 	"github.com/isangeles/flame/item"
 	"github.com/isangeles/flame/log"
@@ -42,6 +44,12 @@ type EquipmentSlot struct {
 	item     item.Equiper
 }
 
+var (
+	INVALID_SLOT        = errors.New("invalid equipment slot")
+	EQUIP_REQS_NOT_MEET = errors.New("equip requirements not meet")
+	CLASS_RESTRICTED    = errors.New("item not allowed for class")
+)
+
 // newEquipment creates new equipment for
 // specified character.
 func newEquipment(char *Character) *Equipment {
@@ -59,6 +67,28 @@ func newEquipment(char *Character) *Equipment {
 	return eq
 }
 
+// Equip inserts specified item to specified equipment slot.
+// Returns an error if the slot is not compatible with the item,
+// character does not meet the item equip requirements or item
+// is not allowed for the character class.
+func (eq *Equipment) Equip(it item.Equiper, slot *EquipmentSlot) error {
+	compatible := false
+	for _, s := range it.Slots() {
+		compatible = compatible || s == slot.Type()
+	}
+	if !compatible {
+		return INVALID_SLOT
+	}
+	if !eq.char.MeetReqs(it.EquipReqs()...) {
+		return EQUIP_REQS_NOT_MEET
+	}
+	if !eq.char.Class().CanEquip(it) {
+		return CLASS_RESTRICTED
+	}
+	slot.SetItem(it)
+	return nil
+}
+
 // Unequip removes specified item from all
 // compatible slots.
 func (eq *Equipment) Unequip(it item.Equiper) {
@@ -183,7 +213,10 @@ func (eqSlot *EquipmentSlot) Item() item.Equiper {
 	return eqSlot.item
 }
 
-// SetItem sets inserts specified item to slot.
+// SetItem inserts specified item to slot.
+// Item equip requirements and class restrictions are
+// not checked, use Equipment.Equip to equip items
+// with all checks.
 func (eqSlot *EquipmentSlot) SetItem(it item.Equiper) {
 	eqSlot.item = it
 }
@@ -0,0 +1,104 @@
+/*
+ * faction.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// Struct for character faction.
+type Faction struct {
+	id        string
+	hostile   int
+	friendly  int
+	relations []res.FactionRelationData
+}
+
+const (
+	defHostileReputation  = -100
+	defFriendlyReputation = 100
+)
+
+// NewFaction creates new faction.
+// Default reputation threshold is used for each threshold
+// not specified in data, and for both thresholds if the hostile
+// threshold is not lower than the friendly one.
+func NewFaction(data res.FactionData) Faction {
+	f := Faction{data.ID, defHostileReputation, defFriendlyReputation, data.Relations}
+	if data.Hostile != nil {
+		f.hostile = *data.Hostile
+	}
+	if data.Friendly != nil {
+		f.friendly = *data.Friendly
+	}
+	if f.hostile >= f.friendly {
+		log.Err.Printf("faction: %s: invalid reputation thresholds: %d >= %d",
+			f.ID(), f.hostile, f.friendly)
+		f.hostile = defHostileReputation
+		f.friendly = defFriendlyReputation
+	}
+	return f
+}
+
+// ID returns faction ID.
+func (f Faction) ID() string {
+	return f.id
+}
+
+// HostileReputation returns reputation value at which the
+// faction becomes hostile.
+func (f Faction) HostileReputation() int {
+	return f.hostile
+}
+
+// FriendlyReputation returns reputation value at which the
+// faction becomes friendly.
+func (f Faction) FriendlyReputation() int {
+	return f.friendly
+}
+
+// Relation returns reputation of all members of the faction
+// with specified ID.
+func (f Faction) Relation(id string) int {
+	for _, r := range f.relations {
+		if r.Faction == id {
+			return r.Value
+		}
+	}
+	return 0
+}
+
+// Attitude returns faction attitude for specified
+// reputation value.
+func (f Faction) Attitude(reputation int) Attitude {
+	switch {
+	case reputation <= f.hostile:
+		return Hostile
+	case reputation >= f.friendly:
+		return Friendly
+	default:
+		return Neutral
+	}
+}
@@ -25,16 +25,27 @@ package character
 
 import (
 	"fmt"
+
+	"github.com/isangeles/flame/data/res"
 )
 
 // Guild struct represents chracter guild
 type Guild struct {
-	id string
+	id      string
+	faction *Faction
 }
 
-// NewGuild return new guild with specified parameters
+// NewGuild return new guild with specified parameters.
+// Faction of the guild is created from faction data
+// with the same ID as guild, if available.
 func NewGuild(id string) Guild {
-	return Guild{id}
+	g := Guild{id: id}
+	data := res.Faction(id)
+	if data != nil {
+		f := NewFaction(*data)
+		g.faction = &f
+	}
+	return g
 }
 
 // ID return guild ID.
@@ -42,6 +53,12 @@ func (g Guild) ID() string {
 	return g.id
 }
 
+// Faction returns faction with the same ID as guild
+// or nil if there is no faction data for the guild.
+func (g Guild) Faction() *Faction {
+	return g.faction
+}
+
 // String returns guild ID
 func (g Guild) String() string {
 	return fmt.Sprintf("%s", g.id)
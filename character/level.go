@@ -0,0 +1,185 @@
+/*
+ * level.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"errors"
+	"math"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/skill"
+)
+
+const (
+	killExp         = 100
+	killExpLevelMod = 0.1 // per level of difference
+	killExpMaxMod   = 2
+)
+
+var (
+	INVALID_ATTRIBUTE   = errors.New("invalid attribute")
+	INVALID_POINTS      = errors.New("invalid number of points")
+	NO_ATTRIBUTE_POINTS = errors.New("not enough attribute points")
+	NO_SPENT_POINTS     = errors.New("not enough spent points")
+)
+
+// AttributePoints returns number of attribute points
+// available for the character.
+func (c *Character) AttributePoints() int {
+	return c.attrPoints
+}
+
+// SetAttributePoints sets number of attribute points
+// available for the character.
+func (c *Character) SetAttributePoints(points int) {
+	c.attrPoints = points
+}
+
+// SetOnLevelUpFunc sets function triggered after character
+// promotion to the next level.
+// The event function will be called with data of the new
+// character level, including level rewards.
+func (c *Character) SetOnLevelUpFunc(f func(level res.LevelData)) {
+	c.onLevelUp = f
+}
+
+// levelup promotes character to next level.
+// Grants character all rewards for the new level
+// specified in the level data.
+func (c *Character) levelup() {
+	c.level += 1
+	level := res.LevelData{Level: c.Level()}
+	if data := res.Level(c.Level()); data != nil {
+		level = *data
+	}
+	c.attrPoints += level.AttrPoints
+	c.addClassGrowth()
+	for _, sd := range level.Skills {
+		if _, ok := c.skills.Load(sd.ID); ok {
+			continue
+		}
+		data := res.Skill(sd.ID)
+		if data == nil {
+			log.Err.Printf("char: %s %s: levelup: skill data not found: %s",
+				c.ID(), c.Serial(), sd.ID)
+			continue
+		}
+		c.AddSkill(skill.New(*data))
+	}
+	c.SetHealth(c.MaxHealth())
+	c.SetMana(c.MaxMana())
+	if c.onLevelUp != nil {
+		c.onLevelUp(level)
+	}
+}
+
+// levelBonus returns health and mana bonus for the
+// character level.
+// Base health and mana bonuses are used for levels
+// without level data or without bonus values.
+func (c *Character) levelBonus() (health, mana int) {
+	for l := 1; l <= c.Level(); l++ {
+		levelHealth, levelMana := BaseHealth, BaseMana/2
+		if d := res.Level(l); d != nil {
+			if d.Health > 0 {
+				levelHealth = d.Health
+			}
+			if d.Mana > 0 {
+				levelMana = d.Mana
+			}
+		}
+		health += levelHealth
+		mana += levelMana
+	}
+	return
+}
+
+// killExperience returns experience for killing the character
+// by a killer with specified level.
+// Experience is scaled by the level difference between the
+// character and the killer.
+func (c *Character) killExperience(level int) int {
+	exp := killExp * c.Level()
+	if data := res.Level(c.Level()); data != nil && data.KillExp > 0 {
+		exp = data.KillExp
+	}
+	mod := 1 + killExpLevelMod*float64(c.Level()-level)
+	mod = math.Min(math.Max(mod, 0), killExpMaxMod)
+	return int(float64(exp) * mod)
+}
+
+// SpentAttributes returns attribute points spent by the character
+// on each attribute.
+func (c *Character) SpentAttributes() Attributes {
+	return c.spentAttrs
+}
+
+// SpendAttributePoints spends specified number of character attribute
+// points on specified attribute.
+func (c *Character) SpendAttributePoints(attr Attribute, points int) error {
+	value := c.Attributes().Value(attr)
+	if value == nil {
+		return INVALID_ATTRIBUTE
+	}
+	if points < 1 {
+		return INVALID_POINTS
+	}
+	if points > c.AttributePoints() {
+		return NO_ATTRIBUTE_POINTS
+	}
+	*value += points
+	*c.spentAttrs.Value(attr) += points
+	c.attrPoints -= points
+	return nil
+}
+
+// RefundAttributePoints moves specified number of attribute points
+// spent on specified attribute back to the character attribute points.
+func (c *Character) RefundAttributePoints(attr Attribute, points int) error {
+	value := c.Attributes().Value(attr)
+	if value == nil {
+		return INVALID_ATTRIBUTE
+	}
+	if points < 1 {
+		return INVALID_POINTS
+	}
+	spent := c.spentAttrs.Value(attr)
+	if points > *spent {
+		return NO_SPENT_POINTS
+	}
+	*value -= points
+	*spent -= points
+	c.attrPoints += points
+	return nil
+}
+
+// Respec refunds all attribute points spent by the character.
+func (c *Character) Respec() {
+	for _, attr := range []Attribute{Strength, Constitution, Dexterity, Wisdom, Intelligence} {
+		if spent := *c.spentAttrs.Value(attr); spent > 0 {
+			c.RefundAttributePoints(attr, spent)
+		}
+	}
+}
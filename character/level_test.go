@@ -0,0 +1,165 @@
+/*
+ * level_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+)
+
+// TestLevelup tests character promotion with
+// level data.
+func TestLevelup(t *testing.T) {
+	// Create test objects
+	res.Levels = []res.LevelData{
+		{Level: 1, Exp: 500, Health: 10, Mana: 10},
+		{Level: 2, Exp: 1500, Health: 20, Mana: 5, AttrPoints: 2,
+			Skills: []res.ObjectSkillData{{ID: "levelSkill"}}},
+	}
+	res.Skills = append(res.Skills, res.SkillData{ID: "levelSkill"})
+	defer func() { res.Levels = nil }()
+	char := New(res.CharacterData{ID: "char", Level: 1})
+	var level res.LevelData
+	char.SetOnLevelUpFunc(func(l res.LevelData) {
+		level = l
+	})
+	// Test
+	if char.MaxExperience() != 500 {
+		t.Errorf("Invalid max experience: %d != 500", char.MaxExperience())
+	}
+	char.SetExperience(500)
+	char.Update(1)
+	if char.Level() != 2 || level.Level != 2 {
+		t.Fatalf("Character not promoted: %d %d", char.Level(), level.Level)
+	}
+	if char.AttributePoints() != 2 {
+		t.Errorf("Invalid attribute points: %d != 2", char.AttributePoints())
+	}
+	health := char.MaxHealth() - char.Attributes().Health()
+	mana := char.MaxMana() - char.Attributes().Mana()
+	if health != 30 || mana != 15 {
+		t.Errorf("Invalid level health and mana: %d %d != 30 15", health, mana)
+	}
+	if char.Health() != char.MaxHealth() {
+		t.Errorf("Health not restored: %d != %d", char.Health(), char.MaxHealth())
+	}
+	if len(char.Skills()) != 1 || char.Skills()[0].ID() != "levelSkill" {
+		t.Errorf("Level skill not granted")
+	}
+	if char.MaxExperience() != 1500 {
+		t.Errorf("Invalid max experience: %d != 1500", char.MaxExperience())
+	}
+	char.SetExperience(1500)
+	char.Update(1)
+	health = char.MaxHealth() - char.Attributes().Health()
+	if health != 30+BaseHealth {
+		t.Errorf("Invalid level health for level without data: %d != %d",
+			health, 30+BaseHealth)
+	}
+}
+
+// TestLevelBonus tests health and mana bonuses for
+// levels with only experience data and for duplicated
+// level data.
+func TestLevelBonus(t *testing.T) {
+	// Create test objects
+	res.Levels = []res.LevelData{
+		{Level: 1, Exp: 500},
+		{Level: 2, Exp: 1500},
+	}
+	defer func() { res.Levels = nil }()
+	char := New(res.CharacterData{ID: "char", Level: 2})
+	// Test
+	health, mana := char.levelBonus()
+	if health != BaseHealth*2 || mana != BaseMana {
+		t.Errorf("Invalid level bonus for exp-only levels: %d %d != %d %d",
+			health, mana, BaseHealth*2, BaseMana)
+	}
+	res.Levels = []res.LevelData{{Level: 1, Health: 10, Mana: 10}}
+	res.Levels = append(res.Levels, res.Levels...)
+	health, mana = char.levelBonus()
+	if health != 10+BaseHealth || mana != 10+BaseMana/2 {
+		t.Errorf("Invalid level bonus for duplicated levels: %d %d != %d %d",
+			health, mana, 10+BaseHealth, 10+BaseMana/2)
+	}
+}
+
+// TestKillExperience tests experience for kills.
+func TestKillExperience(t *testing.T) {
+	// Create test objects
+	char := New(res.CharacterData{ID: "char", Level: 5})
+	// Test
+	if exp := char.killExperience(5); exp != killExp*5 {
+		t.Errorf("Invalid kill experience: %d != %d", exp, killExp*5)
+	}
+	if exp := char.killExperience(3); exp <= killExp*5 {
+		t.Errorf("Kill experience not increased for lower killer level: %d", exp)
+	}
+	if exp := char.killExperience(10); exp >= killExp*5 {
+		t.Errorf("Kill experience not decreased for higher killer level: %d", exp)
+	}
+	if exp := char.killExperience(100); exp != 0 {
+		t.Errorf("Invalid kill experience for much higher killer level: %d != 0", exp)
+	}
+}
+
+// TestAttributePoints tests spending and refunding
+// attribute points.
+func TestAttributePoints(t *testing.T) {
+	// Create test objects
+	char := New(res.CharacterData{ID: "char", Level: 1, AttrPoints: 5})
+	// Test
+	if err := char.SpendAttributePoints(Strength, 6); err != NO_ATTRIBUTE_POINTS {
+		t.Errorf("Invalid spend error: %v != %v", err, NO_ATTRIBUTE_POINTS)
+	}
+	if err := char.SpendAttributePoints(Attribute("luck"), 1); err != INVALID_ATTRIBUTE {
+		t.Errorf("Invalid spend error: %v != %v", err, INVALID_ATTRIBUTE)
+	}
+	if err := char.SpendAttributePoints(Strength, 3); err != nil {
+		t.Fatalf("Unable to spend attribute points: %v", err)
+	}
+	char.SpendAttributePoints(Wisdom, 2)
+	if char.Attributes().Str != 3 || char.Attributes().Wis != 2 || char.AttributePoints() != 0 {
+		t.Errorf("Invalid attributes after spending points: %v %d",
+			char.Attributes(), char.AttributePoints())
+	}
+	if err := char.RefundAttributePoints(Dexterity, 1); err != NO_SPENT_POINTS {
+		t.Errorf("Invalid refund error: %v != %v", err, NO_SPENT_POINTS)
+	}
+	char.RefundAttributePoints(Strength, 1)
+	if char.Attributes().Str != 2 || char.AttributePoints() != 1 {
+		t.Errorf("Invalid attributes after refund: %v %d", char.Attributes(),
+			char.AttributePoints())
+	}
+	char.Apply(char.Data())
+	if char.SpentAttributes().Str != 2 {
+		t.Errorf("Invalid spent attributes after restore: %v", char.SpentAttributes())
+	}
+	char.Respec()
+	if char.Attributes().Str != 0 || char.Attributes().Wis != 0 || char.AttributePoints() != 5 {
+		t.Errorf("Invalid attributes after respec: %v %d", char.Attributes(),
+			char.AttributePoints())
+	}
+}
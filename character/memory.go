@@ -28,8 +28,14 @@ type TargetMemory struct {
 	TargetID     string
 	TargetSerial string
 	Attitude     Attitude
+	Time         int64 // millis since memorized
+	Duration     int64 // millis, no expiry if <= 0
 }
 
+const (
+	defMemoryTime = 300000 // millis
+)
+
 // Memory returns character tergets memory.
 func (c *Character) Memory() (mem []*TargetMemory) {
 	addMemory := func(k, v interface{}) bool {
@@ -47,3 +53,85 @@ func (c *Character) Memory() (mem []*TargetMemory) {
 func (c *Character) MemorizeTarget(mem *TargetMemory) {
 	c.memory.Store(mem.TargetID+mem.TargetSerial, mem)
 }
+
+// MemoryTime returns duration of hostile memories caused
+// by attacks on the character, in milliseconds.
+// Returns default duration if the memory time was not set.
+// Memories with duration less or equal to 0 never expire.
+func (c *Character) MemoryTime() int64 {
+	if c.memoryTime == 0 {
+		return defMemoryTime
+	}
+	return c.memoryTime
+}
+
+// SetMemoryTime sets duration of hostile memories caused
+// by attacks on the character, in milliseconds.
+func (c *Character) SetMemoryTime(time int64) {
+	c.memoryTime = time
+}
+
+// HelpRange returns range in which the character calls
+// allies for help when attacked.
+func (c *Character) HelpRange() float64 {
+	return c.helpRange
+}
+
+// SetHelpRange sets range in which the character calls
+// allies for help when attacked. Range equal to 0 disables
+// calling for help.
+func (c *Character) SetHelpRange(helpRange float64) {
+	c.helpRange = helpRange
+}
+
+// HelpCalls returns memories of attackers that the character
+// calls for help against.
+func (c *Character) HelpCalls() []*TargetMemory {
+	return c.helpCalls
+}
+
+// ClearHelpCalls removes all character calls for help.
+func (c *Character) ClearHelpCalls() {
+	c.helpCalls = nil
+}
+
+// Ally checks if specified character is an ally, i.e.
+// belongs to the same guild or race.
+func (c *Character) Ally(char *Character) bool {
+	if char == c {
+		return false
+	}
+	if len(c.Guild().ID()) > 0 && c.Guild().ID() == char.Guild().ID() {
+		return true
+	}
+	return len(c.Race().ID()) > 0 && c.Race().ID() == char.Race().ID()
+}
+
+// Alert memorizes specified target as hostile in response
+// to a call for help from an ally.
+func (c *Character) Alert(mem *TargetMemory) {
+	if mem.TargetID == c.ID() && mem.TargetSerial == c.Serial() {
+		return
+	}
+	alert := TargetMemory{
+		TargetID:     mem.TargetID,
+		TargetSerial: mem.TargetSerial,
+		Attitude:     Hostile,
+		Duration:     c.MemoryTime(),
+	}
+	c.MemorizeTarget(&alert)
+}
+
+// updateMemory updates memorized targets and removes
+// expired memories.
+func (c *Character) updateMemory(delta int64) {
+	for _, m := range c.Memory() {
+		if m.Duration <= 0 {
+			continue
+		}
+		m.Time += delta
+		if m.Time >= m.Duration {
+			c.memory.Delete(m.TargetID + m.TargetSerial)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * memory_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+)
+
+// TestMemoryUpdate tests memory expiry.
+func TestMemoryUpdate(t *testing.T) {
+	// Create test objects
+	char := New(charData)
+	tar := New(charData)
+	char.SetMemoryTime(1000)
+	tarMem := TargetMemory{
+		TargetID:     tar.ID(),
+		TargetSerial: tar.Serial(),
+		Attitude:     Friendly,
+	}
+	char.MemorizeTarget(&tarMem)
+	// Test
+	char.Alert(&tarMem)
+	if char.AttitudeFor(tar) != Hostile {
+		t.Errorf("Invalid attitude after alert: %v != %v", char.AttitudeFor(tar), Hostile)
+	}
+	char.Update(500)
+	data := char.Data()
+	if len(data.Memory) != 1 || data.Memory[0].Time != 500 {
+		t.Errorf("Invalid memory data: %v", data.Memory)
+	}
+	char.Update(500)
+	if len(char.Memory()) > 0 {
+		t.Errorf("Memory not expired: %d", len(char.Memory()))
+	}
+	char.Apply(data)
+	if len(char.Memory()) != 1 || char.Memory()[0].Time != 500 {
+		t.Errorf("Memory not restored: %v", char.Memory())
+	}
+	tarMem.Duration = 0
+	char.MemorizeTarget(&tarMem)
+	char.Update(defMemoryTime)
+	if len(char.Memory()) != 1 {
+		t.Errorf("Permanent memory expired")
+	}
+}
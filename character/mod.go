@@ -71,8 +71,21 @@ func (c *Character) takeModifier(s serial.Serialer, m effect.Modifier) {
 		if s == nil {
 			break
 		}
+		if val < 0 {
+			c.AddThreat(s, float64(-val))
+		} else if val > 0 {
+			c.healThreat(s, val)
+		}
+		// Kill credit goes to the owner of the source.
+		if sc, ok := s.(*Character); ok && sc.Owner() != nil {
+			s = sc.Owner()
+		}
 		if s, ok := s.(objects.Killer); ok && lived && !c.Live() {
-			kill := res.KillData{c.ID(), c.Serial(), 100 * c.Level()}
+			level := c.Level()
+			if killer, ok := s.(*Character); ok {
+				level = killer.Level()
+			}
+			kill := res.KillData{c.ID(), c.Serial(), c.killExperience(level)}
 			s.AddKill(kill)
 		}
 	case *effect.ManaMod:
@@ -147,14 +160,26 @@ func (c *Character) takeModifier(s serial.Serialer, m effect.Modifier) {
 			TargetID:     s.ID(),
 			TargetSerial: s.Serial(),
 			Attitude:     Attitude(m.Attitude()),
+			Duration:     m.Duration(),
 		}
 		c.MemorizeTarget(&tar)
+	case *effect.ReputationMod:
+		rep := c.Reputation(m.FactionID()) + m.Value()
+		c.SetReputation(m.FactionID(), rep)
+	case *effect.SummonMod:
+		c.summon(m)
 	case *effect.ChapterMod:
 		c.SetChapterID(m.ChapterID())
 	case *effect.MoveSpeedMod:
 		c.Attributes().MoveMod += m.Value()
 	case *effect.VisibilityMod:
 		c.Attributes().VisibilityMod += m.Value()
+	case *effect.SightMod:
+		c.Attributes().SightMod += m.Value()
+	case *effect.TauntMod:
+		if s != nil {
+			c.AddThreat(s, m.Value())
+		}
 	}
 	if c.onModifierTaken != nil {
 		c.onModifierTaken(m)
@@ -173,5 +198,7 @@ func (c *Character) removeModifier(s serial.Serialer, m effect.Modifier) {
 		c.RemoveFlag(m.Flag())
 	case *effect.MoveSpeedMod:
 		c.Attributes().MoveMod -= m.Value()
+	case *effect.SightMod:
+		c.Attributes().SightMod -= m.Value()
 	}
 }
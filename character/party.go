@@ -0,0 +1,56 @@
+/*
+ * party.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+// Interface for character party.
+type Party interface {
+	ID() string
+	Members() []*Character
+}
+
+// Party returns character party or nil if
+// character is not a party member.
+func (c *Character) Party() Party {
+	return c.party
+}
+
+// SetParty sets character party.
+func (c *Character) SetParty(p Party) {
+	c.party = p
+}
+
+// partyMembers returns all living party members in the
+// same area as the character, including the character.
+func (c *Character) partyMembers() []*Character {
+	members := []*Character{c}
+	if c.Party() == nil {
+		return members
+	}
+	for _, m := range c.Party().Members() {
+		if m != c && m.Live() && m.AreaID() == c.AreaID() {
+			members = append(members, m)
+		}
+	}
+	return members
+}
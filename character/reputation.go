@@ -0,0 +1,69 @@
+/*
+ * reputation.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Reputation returns character reputation with faction
+// with specified ID.
+func (c *Character) Reputation(faction string) int {
+	val, _ := c.reputation.Load(faction)
+	rep, _ := val.(int)
+	return rep
+}
+
+// SetReputation sets character reputation with faction
+// with specified ID.
+func (c *Character) SetReputation(faction string, value int) {
+	c.reputation.Store(faction, value)
+}
+
+// Reputations returns all character reputation values.
+func (c *Character) Reputations() (reps []res.ReputationData) {
+	addReputation := func(k, v interface{}) bool {
+		faction, ok := k.(string)
+		value, _ := v.(int)
+		if ok {
+			reps = append(reps, res.ReputationData{faction, value})
+		}
+		return true
+	}
+	c.reputation.Range(addReputation)
+	return
+}
+
+// factionAttitude returns attitude of the character faction
+// for specified character, based on the character reputation
+// and relations between the factions.
+// Returns neutral attitude if the character has no faction.
+func (c *Character) factionAttitude(char *Character) Attitude {
+	faction := c.Guild().Faction()
+	if faction == nil {
+		return Neutral
+	}
+	rep := char.Reputation(faction.ID()) + faction.Relation(char.Guild().ID())
+	return faction.Attitude(rep)
+}
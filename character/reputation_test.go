@@ -0,0 +1,139 @@
+/*
+ * reputation_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/req"
+)
+
+// TestReputationAttitude tests attitude for characters
+// based on faction reputation.
+func TestReputationAttitude(t *testing.T) {
+	// Create test objects
+	guards := res.FactionData{
+		ID:        "guards",
+		Relations: []res.FactionRelationData{{"bandits", -200}},
+	}
+	res.Factions = append(res.Factions, guards)
+	guard := New(charData)
+	guard.SetGuild(NewGuild("guards"))
+	guard.SetAttitude(Friendly)
+	player := New(charData)
+	player.SetAttitude(Friendly)
+	bandit := New(charData)
+	bandit.SetAttitude(Friendly)
+	bandit.SetGuild(NewGuild("bandits"))
+	repMod := effect.NewModifiers(res.ModifiersData{
+		ReputationMods: []res.ReputationModData{{"guards", -60}},
+	})
+	// Test
+	if att := guard.AttitudeFor(player); att != Friendly {
+		t.Errorf("Invalid attitude for neutral reputation: %v != %v", att, Friendly)
+	}
+	if att := guard.AttitudeFor(bandit); att != Hostile {
+		t.Errorf("Invalid attitude for hostile faction: %v != %v", att, Hostile)
+	}
+	player.TakeModifiers(nil, repMod...)
+	player.TakeModifiers(nil, repMod...)
+	if player.Reputation("guards") != -120 {
+		t.Errorf("Invalid reputation value: %d != -120", player.Reputation("guards"))
+	}
+	if att := guard.AttitudeFor(player); att != Hostile {
+		t.Errorf("Invalid attitude for low reputation: %v != %v", att, Hostile)
+	}
+	player.SetReputation("guards", 150)
+	if att := guard.AttitudeFor(player); att != Friendly {
+		t.Errorf("Invalid attitude for high reputation: %v != %v", att, Friendly)
+	}
+}
+
+// TestFactionThresholds tests faction with only one
+// reputation threshold specified.
+func TestFactionThresholds(t *testing.T) {
+	// Create test objects
+	low, zero, high := -50, 0, 50
+	friendly := NewFaction(res.FactionData{ID: "friendly", Friendly: &high})
+	hostile := NewFaction(res.FactionData{ID: "hostile", Hostile: &low})
+	zeroHostile := NewFaction(res.FactionData{ID: "zeroHostile", Hostile: &zero, Friendly: &high})
+	invalid := NewFaction(res.FactionData{ID: "invalid", Hostile: &high, Friendly: &low})
+	// Test
+	if att := friendly.Attitude(0); att != Neutral {
+		t.Errorf("Invalid attitude for friendly threshold only: %v != %v", att, Neutral)
+	}
+	if att := friendly.Attitude(50); att != Friendly {
+		t.Errorf("Invalid attitude for friendly reputation: %v != %v", att, Friendly)
+	}
+	if att := hostile.Attitude(0); att != Neutral {
+		t.Errorf("Invalid attitude for hostile threshold only: %v != %v", att, Neutral)
+	}
+	if att := hostile.Attitude(-50); att != Hostile {
+		t.Errorf("Invalid attitude for hostile reputation: %v != %v", att, Hostile)
+	}
+	if att := zeroHostile.Attitude(0); att != Hostile {
+		t.Errorf("Invalid attitude for zero hostile threshold: %v != %v", att, Hostile)
+	}
+	if invalid.HostileReputation() != defHostileReputation ||
+		invalid.FriendlyReputation() != defFriendlyReputation {
+		t.Errorf("Invalid thresholds not replaced: %d %d", invalid.HostileReputation(),
+			invalid.FriendlyReputation())
+	}
+}
+
+// TestReputationData tests reputation requirements
+// and data.
+func TestReputationData(t *testing.T) {
+	// Create test objects
+	data := charData
+	data.TradeReqs = res.ReqsData{
+		ReputationReqs: []res.ReputationReqData{{Faction: "guards", Value: 50}},
+	}
+	trader := New(data)
+	player := New(charData)
+	repReq := req.NewReputation(res.ReputationReqData{Faction: "guards", Value: 10, Less: true})
+	// Test
+	if !player.MeetReqs(repReq) {
+		t.Errorf("Reputation requirement not meet")
+	}
+	if trader.TradeAvailableFor(player) {
+		t.Errorf("Trade available without required reputation")
+	}
+	player.SetReputation("guards", 50)
+	if player.MeetReqs(repReq) {
+		t.Errorf("Reputation requirement meet")
+	}
+	if !trader.TradeAvailableFor(player) {
+		t.Errorf("Trade not available with required reputation")
+	}
+	player.Apply(player.Data())
+	if player.Reputation("guards") != 50 {
+		t.Errorf("Invalid restored reputation value: %d != 50", player.Reputation("guards"))
+	}
+	if len(trader.Data().TradeReqs.ReputationReqs) != 1 {
+		t.Errorf("Invalid trade requirements data")
+	}
+}
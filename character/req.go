@@ -67,6 +67,13 @@ func (c *Character) meetReq(r req.Requirement) bool {
 			return !c.HasFlag(f)
 		}
 		return c.HasFlag(f)
+	case *req.WorldFlag:
+		hasFlag := c.Environment() != nil &&
+			c.Environment().HasWorldFlag(flag.Flag(r.FlagID()))
+		if r.FlagOff() {
+			return !hasFlag
+		}
+		return hasFlag
 	case *req.Item:
 		count := 0
 		for _, i := range c.Inventory().Items() {
@@ -93,7 +100,7 @@ func (c *Character) meetReq(r req.Requirement) bool {
 			return true
 		}
 		tar := c.Targets()[0]
-		return objects.Range(c, tar) <= r.MinRange()
+		return objects.Range(c, tar) <= r.MinRange() && c.LineOfSight(tar.Position())
 	case *req.Kill:
 		amount := 0
 		for _, k := range c.Kills() {
@@ -145,6 +152,39 @@ func (c *Character) meetReq(r req.Requirement) bool {
 			}
 		}
 		return false
+	case *req.TimeOfDay:
+		if c.Environment() == nil || c.Environment().Clock() == nil {
+			return false
+		}
+		clock := c.Environment().Clock()
+		return r.MeetTime(clock.DayPhase(), clock.Hour())
+	case *req.Reputation:
+		if r.Less() {
+			return c.Reputation(r.FactionID()) < r.Value()
+		}
+		return c.Reputation(r.FactionID()) >= r.Value()
+	case *req.Class:
+		if r.Off() {
+			return c.Class().ID() != r.ID()
+		}
+		return c.Class().ID() == r.ID()
+	case *req.Party:
+		members := []*Character{c}
+		if c.Party() != nil {
+			members = c.Party().Members()
+		}
+		if len(members) < r.Members() {
+			return false
+		}
+		if !r.SameArea() {
+			return true
+		}
+		for _, m := range members {
+			if m.AreaID() != c.AreaID() {
+				return false
+			}
+		}
+		return true
 	default:
 		return true
 	}
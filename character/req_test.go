@@ -26,8 +26,10 @@ package character
 import (
 	"testing"
 
+	"github.com/isangeles/flame/clock"
 	"github.com/isangeles/flame/data/res"
 	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/flag"
 	"github.com/isangeles/flame/item"
 	"github.com/isangeles/flame/req"
 )
@@ -221,6 +223,57 @@ func TestMeetReqsEffect(t *testing.T) {
 	}
 }
 
+// TestMeetReqsTimeOfDay tests meet requirement check function
+// for time of day requirement.
+func TestMeetReqsTimeOfDay(t *testing.T) {
+	// Create object & requirement
+	char := New(charData)
+	env := testEnvironment{clock.New(res.ClockData{
+		DayPhases: []res.DayPhaseData{{ID: "day", Start: 6}, {ID: "night", Start: 20}},
+	}), nil}
+	timeReq := req.NewTimeOfDay(res.TimeOfDayReqData{Phase: "night", From: 22, To: 4})
+	// No environment
+	if char.MeetReqs(timeReq) {
+		t.Errorf("Requirement should not be meet")
+	}
+	// Meet
+	char.SetEnvironment(env)
+	env.clock.SetTimeOfDay(2 * clock.HourLength)
+	if !char.MeetReqs(timeReq) {
+		t.Errorf("Requirement should be meet")
+	}
+	// Not meet
+	env.clock.SetTimeOfDay(21 * clock.HourLength)
+	if char.MeetReqs(timeReq) {
+		t.Errorf("Requirement should not be meet")
+	}
+}
+
+// TestMeetReqsWorldFlag tests meet requirement check function
+// for world flag requirement.
+func TestMeetReqsWorldFlag(t *testing.T) {
+	// Create object & requirements
+	char := New(charData)
+	env := testEnvironment{clock.New(res.ClockData{}), []flag.Flag{"flagRaid"}}
+	flagReq := req.NewWorldFlag(res.IDReqData{ID: "flagRaid"})
+	offReq := req.NewWorldFlag(res.IDReqData{ID: "flagRaid", Off: true})
+	// No environment
+	if char.MeetReqs(flagReq) {
+		t.Errorf("Requirement should not be meet")
+	}
+	if !char.MeetReqs(offReq) {
+		t.Errorf("Off requirement should be meet")
+	}
+	// Meet
+	char.SetEnvironment(env)
+	if !char.MeetReqs(flagReq) {
+		t.Errorf("Requirement should be meet")
+	}
+	if char.MeetReqs(offReq) {
+		t.Errorf("Off requirement should not be meet")
+	}
+}
+
 // TestChargeReqs tests charge requirements function.
 func TestChargeReqs(t *testing.T) {
 	// Handle mixed reqs(chargeable and non chargeable)
@@ -328,3 +381,24 @@ func TestChargeReqsCurrency(t *testing.T) {
 		t.Errorf("Currency requirement item 2 should not be removed from the inventory")
 	}
 }
+
+// Struct for test character environment.
+type testEnvironment struct {
+	clock *clock.Clock
+	flags []flag.Flag
+}
+
+func (te testEnvironment) LineOfSight(x1, y1, x2, y2 float64) bool { return true }
+
+func (te testEnvironment) SightMod(x, y float64) float64 { return te.clock.SightMod() }
+
+func (te testEnvironment) Clock() *clock.Clock { return te.clock }
+
+func (te testEnvironment) HasWorldFlag(f flag.Flag) bool {
+	for _, wf := range te.flags {
+		if wf == f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+/*
+ * schedule.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"github.com/isangeles/flame/clock"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/flag"
+	"github.com/isangeles/flame/req"
+)
+
+// Schedule returns all character schedule entries.
+func (c *Character) Schedule() []res.ScheduleEntryData {
+	return c.schedule
+}
+
+// ScheduleEntry returns current schedule entry, or nil
+// if character has no active schedule entry.
+func (c *Character) ScheduleEntry() *res.ScheduleEntryData {
+	for i := range c.schedule {
+		if c.schedule[i].ID == c.scheduleEntry {
+			return &c.schedule[i]
+		}
+	}
+	return nil
+}
+
+// TradeAvailable checks if character is available for trade
+// according to the current schedule entry.
+func (c *Character) TradeAvailable() bool {
+	entry := c.ScheduleEntry()
+	return entry == nil || !entry.NoTrade
+}
+
+// TradeReqs returns requirements for trading with the character.
+func (c *Character) TradeReqs() []req.Requirement {
+	return c.tradeReqs
+}
+
+// TradeAvailableFor checks if character is available for trade
+// with specified object.
+func (c *Character) TradeAvailableFor(ob req.RequirementsTarget) bool {
+	return c.TradeAvailable() && ob.MeetReqs(c.TradeReqs()...)
+}
+
+// updateSchedule switches character to the schedule entry for
+// the current time of the environment clock.
+func (c *Character) updateSchedule() {
+	if len(c.schedule) < 1 || c.Environment() == nil || c.Environment().Clock() == nil {
+		return
+	}
+	entry := c.scheduleEntryAt(c.Environment().Clock().TimeOfDay())
+	if entry == nil || entry.ID == c.scheduleEntry {
+		return
+	}
+	if old := c.ScheduleEntry(); old != nil {
+		for _, fd := range old.Flags {
+			c.RemoveFlag(flag.Flag(fd.ID))
+		}
+	}
+	c.scheduleEntry = entry.ID
+	for _, fd := range entry.Flags {
+		c.AddFlag(flag.Flag(fd.ID))
+	}
+	if len(entry.Area) > 0 && entry.Area != c.AreaID() {
+		areaMod := effect.NewAreaMod(res.AreaModData{ID: entry.Area, EnterX: entry.PosX, EnterY: entry.PosY})
+		c.TakeModifiers(c, areaMod)
+	}
+	c.SetDestPoint(entry.PosX, entry.PosY)
+	c.SetDefaultPosition(entry.PosX, entry.PosY)
+}
+
+// scheduleEntryAt returns schedule entry for specified time of
+// day(in milliseconds).
+// Entry with the latest start before the specified time is the
+// current entry, if there is no such entry then the entry with
+// the latest start(from the previous day) is the current one.
+func (c *Character) scheduleEntryAt(time int64) *res.ScheduleEntryData {
+	var current, last *res.ScheduleEntryData
+	for i := range c.schedule {
+		e := &c.schedule[i]
+		start := scheduleEntryStart(e)
+		if last == nil || start > scheduleEntryStart(last) {
+			last = e
+		}
+		if start <= time && (current == nil || start > scheduleEntryStart(current)) {
+			current = e
+		}
+	}
+	if current == nil {
+		return last
+	}
+	return current
+}
+
+// scheduleDialog checks if dialog with specified ID is available
+// in the current schedule entry.
+func (c *Character) scheduleDialog(id string) bool {
+	entry := c.ScheduleEntry()
+	if entry == nil || len(entry.Dialogs) < 1 {
+		return true
+	}
+	for _, d := range entry.Dialogs {
+		if d.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleEntryStart returns start time of specified schedule
+// entry(in milliseconds).
+func scheduleEntryStart(entry *res.ScheduleEntryData) int64 {
+	return int64(entry.Hour)*clock.HourLength + int64(entry.Minute)*clock.HourLength/60
+}
@@ -0,0 +1,115 @@
+/*
+ * schedule_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/clock"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/flag"
+)
+
+// TestUpdateSchedule tests switching schedule entries
+// on character update.
+func TestUpdateSchedule(t *testing.T) {
+	// Create test objects
+	data := charData
+	data.Area = "home"
+	data.Schedule = []res.ScheduleEntryData{
+		{ID: "market", Hour: 8, Area: "market", PosX: 10, PosY: 20},
+		{ID: "home", Hour: 20, Minute: 30, Area: "home", PosX: 5, PosY: 5,
+			NoTrade: true, Flags: []res.FlagData{{"sleep"}}},
+	}
+	char := New(data)
+	env := testEnvironment{clock.New(res.ClockData{}), nil}
+	char.SetEnvironment(env)
+	// Test
+	env.clock.SetTimeOfDay(9 * clock.HourLength)
+	char.Update(1)
+	if char.ScheduleEntry() == nil || char.ScheduleEntry().ID != "market" {
+		t.Fatalf("Invalid schedule entry: %v", char.ScheduleEntry())
+	}
+	if char.AreaID() != "market" {
+		t.Errorf("Invalid area ID: %s != market", char.AreaID())
+	}
+	if x, y := char.DestPoint(); x != 10 || y != 20 {
+		t.Errorf("Invalid destination point: %fx%f != 10x20", x, y)
+	}
+	if !char.TradeAvailable() {
+		t.Errorf("Trade should be available")
+	}
+	env.clock.SetTimeOfDay(20*clock.HourLength + clock.HourLength/2)
+	char.Update(1)
+	if char.ScheduleEntry() == nil || char.ScheduleEntry().ID != "home" {
+		t.Fatalf("Invalid schedule entry: %v", char.ScheduleEntry())
+	}
+	if char.AreaID() != "home" {
+		t.Errorf("Invalid area ID: %s != home", char.AreaID())
+	}
+	if char.TradeAvailable() {
+		t.Errorf("Trade should not be available")
+	}
+	if !char.HasFlag(flag.Flag("sleep")) {
+		t.Errorf("Schedule entry flag not added")
+	}
+	// Entry from the previous day
+	env.clock.SetTimeOfDay(2 * clock.HourLength)
+	char.Update(1)
+	if char.ScheduleEntry() == nil || char.ScheduleEntry().ID != "home" {
+		t.Errorf("Invalid schedule entry: %v", char.ScheduleEntry())
+	}
+	env.clock.SetTimeOfDay(8 * clock.HourLength)
+	char.Update(1)
+	if char.HasFlag(flag.Flag("sleep")) {
+		t.Errorf("Schedule entry flag not removed")
+	}
+}
+
+// TestScheduleDialog tests dialogs restricted by
+// the schedule entry.
+func TestScheduleDialog(t *testing.T) {
+	// Create test objects
+	data := charData
+	data.Schedule = []res.ScheduleEntryData{
+		{ID: "work", Hour: 8, Dialogs: []res.ObjectDialogData{{ID: "dialog"}}},
+		{ID: "rest", Hour: 18, Dialogs: []res.ObjectDialogData{{ID: "restDialog"}}},
+	}
+	char := New(data)
+	char.AddDialog(dialogData)
+	ob := New(charData)
+	env := testEnvironment{clock.New(res.ClockData{}), nil}
+	char.SetEnvironment(env)
+	// Test
+	env.clock.SetTimeOfDay(19 * clock.HourLength)
+	char.Update(1)
+	if d := char.Dialog(ob); d != nil {
+		t.Errorf("Dialog should not be available: %s", d.ID())
+	}
+	env.clock.SetTimeOfDay(9 * clock.HourLength)
+	char.Update(1)
+	if d := char.Dialog(ob); d == nil {
+		t.Errorf("Dialog should be available")
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * summon.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"math"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/serial"
+)
+
+// Type for summoned character commands.
+type Command string
+
+const (
+	Follow = Command("follow")
+	Guard  = Command("guard")
+	Attack = Command("attack")
+)
+
+const (
+	summonDistance = 30
+)
+
+// Owner returns character owner or nil if
+// character has no owner.
+func (c *Character) Owner() *Character {
+	if len(c.owner.ID) < 1 {
+		return nil
+	}
+	owner, _ := serial.Object(c.owner.ID, c.owner.Serial).(*Character)
+	return owner
+}
+
+// SetOwner sets specified character as owner of
+// this character.
+func (c *Character) SetOwner(owner *Character) {
+	if owner == nil {
+		c.owner = res.SerialObjectData{}
+		return
+	}
+	c.owner = res.SerialObjectData{owner.ID(), owner.Serial()}
+}
+
+// Summons returns all characters owned by the character.
+func (c *Character) Summons() (summons []*Character) {
+	for _, sd := range c.summons {
+		s, ok := serial.Object(sd.ID, sd.Serial).(*Character)
+		if ok {
+			summons = append(summons, s)
+		}
+	}
+	return
+}
+
+// AddSummon adds specified character to the characters
+// owned by the character.
+func (c *Character) AddSummon(s *Character) {
+	s.SetOwner(c)
+	c.summons = append(c.summons, res.SerialObjectData{s.ID(), s.Serial()})
+}
+
+// SummonQueue returns summoned characters waiting to
+// be placed in the owner area.
+func (c *Character) SummonQueue() []*Character {
+	return c.summonQueue
+}
+
+// ClearSummonQueue removes all characters from the summon
+// queue.
+func (c *Character) ClearSummonQueue() {
+	c.summonQueue = nil
+}
+
+// Lifetime returns remaining lifetime of the summoned
+// character in milliseconds, zero means no time limit.
+func (c *Character) Lifetime() int64 {
+	return c.lifetime
+}
+
+// SetLifetime sets lifetime of the summoned character
+// in milliseconds.
+func (c *Character) SetLifetime(lifetime int64) {
+	c.lifetime = lifetime
+}
+
+// Command returns current command of the summoned character.
+func (c *Character) Command() Command {
+	return c.command
+}
+
+// SetCommand sets command for the summoned character.
+func (c *Character) SetCommand(cmd Command) {
+	c.command = cmd
+}
+
+// Expired checks if summoned character should be removed
+// from the game, i.e. summoned character is dead, its
+// lifetime passed or its owner is dead.
+func (c *Character) Expired() bool {
+	if len(c.owner.ID) < 1 {
+		return false
+	}
+	if c.expired || !c.Live() {
+		return true
+	}
+	owner := c.Owner()
+	return owner != nil && !owner.Live()
+}
+
+// summon creates character from specified modifier next to
+// the character and adds it to the summon queue.
+func (c *Character) summon(m *effect.SummonMod) {
+	data := res.Character(m.CharacterID(), "")
+	if data == nil {
+		log.Err.Printf("char: %s %s: summon mod: data not found: %s", c.ID(),
+			c.Serial(), m.CharacterID())
+		return
+	}
+	var summons []*Character
+	for _, s := range c.Summons() {
+		if s.ID() == m.CharacterID() && !s.Expired() {
+			summons = append(summons, s)
+		}
+	}
+	if m.Max() > 0 && len(summons) >= m.Max() {
+		summons[0].expired = true
+	}
+	s := New(*data)
+	angle := 2 * math.Pi * float64(len(c.summons)) / 8
+	x, y := c.Position()
+	x, y = x+math.Cos(angle)*summonDistance, y+math.Sin(angle)*summonDistance
+	s.SetPosition(x, y)
+	s.SetDestPoint(x, y)
+	s.SetDefaultPosition(x, y)
+	s.SetHealth(s.MaxHealth())
+	s.SetLifetime(m.Time())
+	s.SetCommand(Command(m.Command()))
+	if len(s.Command()) < 1 {
+		s.SetCommand(Guard)
+	}
+	s.SetAI(true)
+	c.AddSummon(s)
+	c.summonQueue = append(c.summonQueue, s)
+}
+
+// updateSummons updates lifetime of the summoned character
+// and removes expired characters from character summons.
+// Summon queue of the character outside of the area is
+// dropped.
+func (c *Character) updateSummons(delta int64) {
+	if c.Environment() == nil && len(c.summonQueue) > 0 {
+		for _, s := range c.summonQueue {
+			serial.Unregister(s)
+		}
+		c.summonQueue = nil
+	}
+	if c.lifetime > 0 {
+		c.lifetime -= delta
+		if c.lifetime <= 0 {
+			c.expired = true
+		}
+	}
+	var summons []res.SerialObjectData
+	for _, sd := range c.summons {
+		s, ok := serial.Object(sd.ID, sd.Serial).(*Character)
+		if ok && !s.Expired() {
+			summons = append(summons, sd)
+		}
+	}
+	c.summons = summons
+}
@@ -0,0 +1,114 @@
+/*
+ * summon_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/clock"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+)
+
+// TestSummon tests summoning characters with
+// summon modifier.
+func TestSummon(t *testing.T) {
+	// Create test objects
+	wolfData := res.CharacterData{ID: "wolf", Level: 1}
+	res.Characters = append(res.Characters, wolfData)
+	owner := New(charData)
+	owner.SetHealth(owner.MaxHealth())
+	owner.SetEnvironment(testEnvironment{clock.New(res.ClockData{}), nil})
+	summonMod := effect.NewModifiers(res.ModifiersData{
+		SummonMods: []res.SummonModData{{ID: "wolf", Time: 1000, Max: 1}},
+	})
+	enemy := New(charData)
+	enemy.SetAttitude(Hostile)
+	// Test
+	owner.TakeModifiers(nil, summonMod...)
+	if len(owner.Summons()) != 1 || len(owner.SummonQueue()) != 1 {
+		t.Fatalf("Invalid number of summons: %d", len(owner.Summons()))
+	}
+	wolf := owner.Summons()[0]
+	if wolf.Owner() != owner || wolf.Command() != Guard || !wolf.AI() {
+		t.Errorf("Invalid summon: %v %v %v", wolf.Owner(), wolf.Command(), wolf.AI())
+	}
+	if att := wolf.AttitudeFor(owner); att != Friendly {
+		t.Errorf("Invalid attitude for owner: %v != %v", att, Friendly)
+	}
+	if att := enemy.AttitudeFor(wolf); att != enemy.AttitudeFor(owner) {
+		t.Errorf("Invalid attitude for summon: %v != %v", att, enemy.AttitudeFor(owner))
+	}
+	owner.TakeModifiers(nil, summonMod...)
+	if !wolf.Expired() {
+		t.Errorf("Summon above limit not expired")
+	}
+	owner.Update(1)
+	if len(owner.Summons()) != 1 {
+		t.Errorf("Expired summon not removed: %d", len(owner.Summons()))
+	}
+	wolf = owner.Summons()[0]
+	wolf.Update(1000)
+	if !wolf.Expired() {
+		t.Errorf("Summon not expired after lifetime")
+	}
+	owner.SetEnvironment(nil)
+	owner.Update(1)
+	owner.TakeModifiers(nil, summonMod...)
+	owner.Update(1)
+	if len(owner.SummonQueue()) > 0 || len(owner.Summons()) > 0 {
+		t.Errorf("Summons of owner without area not dropped: %d %d",
+			len(owner.SummonQueue()), len(owner.Summons()))
+	}
+}
+
+// TestSummonOwner tests summon kill credit and
+// owner death.
+func TestSummonOwner(t *testing.T) {
+	// Create test objects
+	owner := New(charData)
+	owner.SetHealth(owner.MaxHealth())
+	pet := New(charData)
+	pet.SetHealth(pet.MaxHealth())
+	owner.AddSummon(pet)
+	enemy := New(charData)
+	enemy.SetHealth(1)
+	damage := effect.NewModifiers(res.ModifiersData{
+		HealthMods: []res.HealthModData{{-10, -10}},
+	})
+	// Test
+	enemy.TakeModifiers(pet, damage...)
+	if len(owner.Kills()) != 1 || len(pet.Kills()) != 0 {
+		t.Errorf("Invalid kill credit: %d %d", len(owner.Kills()), len(pet.Kills()))
+	}
+	data := pet.Data()
+	if data.Owner.Serial != owner.Serial() {
+		t.Errorf("Invalid owner data: %v", data.Owner)
+	}
+	owner.SetHealth(0)
+	owner.Update(1)
+	if !pet.Expired() {
+		t.Errorf("Summon not expired after owner death")
+	}
+}
@@ -0,0 +1,144 @@
+/*
+ * threat.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"math"
+
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/serial"
+)
+
+// Struct for threat table entry.
+type Threat struct {
+	TargetID     string
+	TargetSerial string
+	Value        float64
+}
+
+const (
+	combatTimeout    = 10000 // millis
+	threatDecay      = 0.05  // per second
+	minThreat        = 0.1
+	healThreatFactor = 0.5
+)
+
+// Threats returns all entries from the character threat table.
+func (c *Character) Threats() (threats []*Threat) {
+	addThreat := func(k, v interface{}) bool {
+		t, ok := v.(*Threat)
+		if ok {
+			threats = append(threats, t)
+		}
+		return true
+	}
+	c.threat.Range(addThreat)
+	return
+}
+
+// Threat returns threat value of specified object.
+func (c *Character) Threat(o serial.Serialer) float64 {
+	v, _ := c.threat.Load(o.ID() + o.Serial())
+	t, ok := v.(*Threat)
+	if !ok {
+		return 0
+	}
+	return t.Value
+}
+
+// AddThreat adds specified value to the threat of
+// specified object and resets the combat timer.
+func (c *Character) AddThreat(o serial.Serialer, value float64) {
+	if o.ID() == c.ID() && o.Serial() == c.Serial() {
+		return
+	}
+	v, _ := c.threat.Load(o.ID() + o.Serial())
+	t, ok := v.(*Threat)
+	if !ok {
+		t = &Threat{TargetID: o.ID(), TargetSerial: o.Serial()}
+		c.threat.Store(o.ID()+o.Serial(), t)
+	}
+	t.Value += value
+	c.combatTime = combatTimeout
+}
+
+// ClearThreat removes all entries from the character
+// threat table.
+func (c *Character) ClearThreat() {
+	c.threat.Range(func(k, v interface{}) bool {
+		c.threat.Delete(k)
+		return true
+	})
+	c.combatTime = 0
+}
+
+// HighestThreat returns existing target with the highest
+// threat value, or nil if threat table is empty.
+func (c *Character) HighestThreat() (target effect.Target) {
+	highest := 0.0
+	for _, t := range c.Threats() {
+		tar, ok := serial.Object(t.TargetID, t.TargetSerial).(effect.Target)
+		if ok && t.Value > highest {
+			target, highest = tar, t.Value
+		}
+	}
+	return
+}
+
+// updateThreat decays threat values and removes dead or
+// not existing objects from the threat table.
+// Clears threat table when character leaves the combat.
+func (c *Character) updateThreat(delta int64) {
+	if c.combatTime > 0 {
+		c.combatTime -= delta
+	}
+	if !c.Live() || c.combatTime <= 0 {
+		c.ClearThreat()
+		return
+	}
+	decay := math.Pow(1-threatDecay, float64(delta)/1000)
+	for _, t := range c.Threats() {
+		t.Value *= decay
+		ob := serial.Object(t.TargetID, t.TargetSerial)
+		char, isChar := ob.(*Character)
+		if ob == nil || (isChar && !char.Live()) || t.Value < minThreat {
+			c.threat.Delete(t.TargetID + t.TargetSerial)
+		}
+	}
+	if len(c.Threats()) < 1 {
+		c.combatTime = 0
+	}
+}
+
+// healThreat adds threat caused by specified healing value
+// done by specified object to all enemies from the character
+// threat table.
+func (c *Character) healThreat(o serial.Serialer, value int) {
+	for _, t := range c.Threats() {
+		enemy, ok := serial.Object(t.TargetID, t.TargetSerial).(*Character)
+		if ok {
+			enemy.AddThreat(o, float64(value)*healThreatFactor)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * threat_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package character
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+)
+
+// TestThreat tests threat caused by damage, healing
+// and taunt modifiers.
+func TestThreat(t *testing.T) {
+	// Create test objects
+	npc := New(charData)
+	tank := New(charData)
+	dps := New(charData)
+	healer := New(charData)
+	damage := func(min int) []effect.Modifier {
+		return effect.NewModifiers(res.ModifiersData{
+			HealthMods: []res.HealthModData{{-min, -min}},
+		})
+	}
+	heal := effect.NewModifiers(res.ModifiersData{
+		HealthMods: []res.HealthModData{{10, 10}},
+	})
+	taunt := effect.NewModifiers(res.ModifiersData{
+		TauntMods: []res.ValueModData{{100}},
+	})
+	// Test
+	npc.TakeModifiers(tank, damage(2)...)
+	npc.TakeModifiers(dps, damage(10)...)
+	if npc.Threat(dps) != 10 {
+		t.Errorf("Invalid threat value: %f != 10", npc.Threat(dps))
+	}
+	if npc.HighestThreat() != dps {
+		t.Errorf("Invalid highest threat target: %v", npc.HighestThreat())
+	}
+	if !npc.Fighting() {
+		t.Errorf("Character with threat not in combat")
+	}
+	dps.TakeModifiers(npc, damage(1)...)
+	dps.TakeModifiers(healer, heal...)
+	if npc.Threat(healer) != 10*healThreatFactor {
+		t.Errorf("Invalid healing threat value: %f != %f", npc.Threat(healer),
+			10*healThreatFactor)
+	}
+	npc.TakeModifiers(tank, taunt...)
+	if npc.HighestThreat() != tank {
+		t.Errorf("Invalid highest threat target after taunt: %v", npc.HighestThreat())
+	}
+}
+
+// TestThreatUpdate tests threat decay and leaving
+// the combat.
+func TestThreatUpdate(t *testing.T) {
+	// Create test objects
+	npc := New(charData)
+	enemy := New(charData)
+	npc.AddThreat(enemy, 10)
+	// Test
+	npc.Update(1000)
+	if npc.Threat(enemy) >= 10 {
+		t.Errorf("Threat value not decayed: %f", npc.Threat(enemy))
+	}
+	data := npc.Data()
+	if len(data.Threat) != 1 || data.CombatTime != combatTimeout-1000 {
+		t.Errorf("Invalid threat data: %v %d", data.Threat, data.CombatTime)
+	}
+	npc.Update(combatTimeout)
+	if len(npc.Threats()) > 0 || npc.Fighting() {
+		t.Errorf("Threat table not cleared after leaving combat")
+	}
+	npc.Apply(data)
+	if len(npc.Threats()) != 1 {
+		t.Errorf("Threat table not restored")
+	}
+}
@@ -0,0 +1,246 @@
+/*
+ * clock.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+// Package with world clock and calendar.
+package clock
+
+import (
+	"time"
+
+	"github.com/isangeles/flame/data/res"
+)
+
+const (
+	// Length of the world day in milliseconds.
+	DayLength = int64(24 * time.Hour / time.Millisecond)
+	// Length of the world hour in milliseconds.
+	HourLength = int64(time.Hour / time.Millisecond)
+)
+
+// Struct for world clock.
+type Clock struct {
+	time      int64
+	remainder float64
+	scale     float64
+	weekDays  []res.CalendarDayData
+	months    []res.CalendarMonthData
+	dayPhases []res.DayPhaseData
+}
+
+// New creates new world clock.
+func New(data res.ClockData) *Clock {
+	c := new(Clock)
+	c.Apply(data)
+	return c
+}
+
+// Update updates clock.
+// Clock time is moved forward by specified delta
+// multiplied by the clock time scale.
+func (c *Clock) Update(delta int64) {
+	c.remainder += float64(delta) * c.scale
+	c.time += int64(c.remainder)
+	c.remainder -= float64(int64(c.remainder))
+}
+
+// Time returns world time in milliseconds.
+func (c *Clock) Time() int64 {
+	return c.time
+}
+
+// SetTime sets world time in milliseconds.
+func (c *Clock) SetTime(time int64) {
+	c.time = time
+}
+
+// Scale returns clock time scale.
+func (c *Clock) Scale() float64 {
+	return c.scale
+}
+
+// SetScale sets clock time scale.
+func (c *Clock) SetScale(scale float64) {
+	c.scale = scale
+}
+
+// TimeOfDay returns time in milliseconds since the
+// start of the current day.
+func (c *Clock) TimeOfDay() int64 {
+	t := c.time % DayLength
+	if t < 0 {
+		t += DayLength
+	}
+	return t
+}
+
+// SetTimeOfDay sets time in milliseconds since the start
+// of the current day.
+func (c *Clock) SetTimeOfDay(t int64) {
+	c.time = c.time - c.TimeOfDay() + t%DayLength
+}
+
+// Hour returns current hour.
+func (c *Clock) Hour() int {
+	return int(c.TimeOfDay() / HourLength)
+}
+
+// Minute returns current minute.
+func (c *Clock) Minute() int {
+	return int(c.TimeOfDay() % HourLength / int64(time.Minute/time.Millisecond))
+}
+
+// Day returns number of days since the start of
+// the world time.
+func (c *Clock) Day() int {
+	day := c.time / DayLength
+	if c.time < 0 && c.time%DayLength != 0 {
+		day--
+	}
+	return int(day)
+}
+
+// Date returns current year, month and day of the month.
+// Returns -1 as month if calendar has no months.
+func (c *Clock) Date() (year, month, day int) {
+	yearDays := c.yearDays()
+	if yearDays < 1 {
+		return 0, -1, c.Day()
+	}
+	day = c.Day() % yearDays
+	year = c.Day() / yearDays
+	if day < 0 {
+		day += yearDays
+		year--
+	}
+	for i, m := range c.months {
+		if day < m.Days {
+			return year, i, day
+		}
+		day -= m.Days
+	}
+	return year, -1, day
+}
+
+// Month returns ID of the current month.
+func (c *Clock) Month() string {
+	_, month, _ := c.Date()
+	if month < 0 {
+		return ""
+	}
+	return c.months[month].ID
+}
+
+// Season returns ID of the current season.
+func (c *Clock) Season() string {
+	_, month, _ := c.Date()
+	if month < 0 {
+		return ""
+	}
+	return c.months[month].Season
+}
+
+// WeekDay returns ID of the current day of the week.
+func (c *Clock) WeekDay() string {
+	if len(c.weekDays) < 1 {
+		return ""
+	}
+	day := c.Day() % len(c.weekDays)
+	if day < 0 {
+		day += len(c.weekDays)
+	}
+	return c.weekDays[day].ID
+}
+
+// DayPhase returns ID of the current day phase.
+func (c *Clock) DayPhase() string {
+	phase := c.dayPhase()
+	if phase == nil {
+		return ""
+	}
+	return phase.ID
+}
+
+// SightMod returns modifier for sight range of
+// characters outdoors for the current day phase.
+func (c *Clock) SightMod() float64 {
+	phase := c.dayPhase()
+	if phase == nil {
+		return 0
+	}
+	return phase.Sight
+}
+
+// Apply applies specified data on the clock.
+func (c *Clock) Apply(data res.ClockData) {
+	c.time = data.Time
+	c.scale = data.Scale
+	if c.scale == 0 {
+		c.scale = 1
+	}
+	c.weekDays = data.WeekDays
+	c.months = data.Months
+	c.dayPhases = data.DayPhases
+}
+
+// Data returns data resource for the clock.
+func (c *Clock) Data() res.ClockData {
+	data := res.ClockData{
+		Time:      c.time,
+		Scale:     c.scale,
+		WeekDays:  c.weekDays,
+		Months:    c.months,
+		DayPhases: c.dayPhases,
+	}
+	return data
+}
+
+// dayPhase returns current day phase, or nil if clock
+// has no day phases.
+// Phase with the latest start before the current hour is
+// the current phase, if there is no such phase then
+// the phase with the latest start is the current one.
+func (c *Clock) dayPhase() *res.DayPhaseData {
+	var current, last *res.DayPhaseData
+	hour := c.Hour()
+	for i := range c.dayPhases {
+		p := &c.dayPhases[i]
+		if last == nil || p.Start > last.Start {
+			last = p
+		}
+		if p.Start <= hour && (current == nil || p.Start > current.Start) {
+			current = p
+		}
+	}
+	if current == nil {
+		return last
+	}
+	return current
+}
+
+// yearDays returns number of days in the calendar year.
+func (c *Clock) yearDays() (days int) {
+	for _, m := range c.months {
+		days += m.Days
+	}
+	return
+}
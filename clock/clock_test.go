@@ -0,0 +1,110 @@
+/*
+ * clock_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package clock
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+)
+
+var clockData = res.ClockData{
+	Scale:    2,
+	WeekDays: []res.CalendarDayData{{"day1"}, {"day2"}, {"day3"}},
+	Months: []res.CalendarMonthData{
+		{ID: "month1", Days: 2, Season: "summer"},
+		{ID: "month2", Days: 3, Season: "winter"},
+	},
+	DayPhases: []res.DayPhaseData{
+		{ID: "day", Start: 6},
+		{ID: "night", Start: 20, Sight: -100},
+	},
+}
+
+// TestClockUpdate tests updating clock with time scale.
+func TestClockUpdate(t *testing.T) {
+	// Create clock
+	c := New(clockData)
+	// Test
+	c.Update(HourLength / 2)
+	if c.Hour() != 1 {
+		t.Errorf("Invalid hour: %d != 1", c.Hour())
+	}
+	c.Update(1)
+	c.SetScale(0.5)
+	c.Update(1)
+	c.Update(1)
+	if c.Time() != HourLength+3 {
+		t.Errorf("Invalid time: %d != %d", c.Time(), HourLength+3)
+	}
+}
+
+// TestClockCalendar tests calendar dates of the clock.
+func TestClockCalendar(t *testing.T) {
+	// Create clock
+	c := New(clockData)
+	// Test
+	c.SetTime(DayLength*8 + HourLength*5)
+	year, month, day := c.Date()
+	if year != 1 || month != 1 || day != 1 {
+		t.Errorf("Invalid date: %d %d %d != 1 1 1", year, month, day)
+	}
+	if c.Month() != "month2" {
+		t.Errorf("Invalid month: %s != month2", c.Month())
+	}
+	if c.Season() != "winter" {
+		t.Errorf("Invalid season: %s != winter", c.Season())
+	}
+	if c.WeekDay() != "day3" {
+		t.Errorf("Invalid week day: %s != day3", c.WeekDay())
+	}
+}
+
+// TestClockDayPhase tests day phases of the clock.
+func TestClockDayPhase(t *testing.T) {
+	// Create clock
+	c := New(clockData)
+	// Test
+	tests := []struct {
+		hour  int64
+		phase string
+		sight float64
+	}{
+		{5, "night", -100},
+		{6, "day", 0},
+		{19, "day", 0},
+		{20, "night", -100},
+	}
+	for _, test := range tests {
+		c.SetTimeOfDay(test.hour * HourLength)
+		if c.DayPhase() != test.phase {
+			t.Errorf("Invalid day phase: %d: %s != %s", test.hour, c.DayPhase(),
+				test.phase)
+		}
+		if c.SightMod() != test.sight {
+			t.Errorf("Invalid sight mod: %d: %f != %f", test.hour, c.SightMod(),
+				test.sight)
+		}
+	}
+}
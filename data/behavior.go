@@ -0,0 +1,107 @@
+/*
+ * behavior.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package data
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// ImportBehaviorTrees imports behavior trees data from base file
+// with specified path.
+func ImportBehaviorTrees(path string) ([]res.BehaviorTreeData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to open data file: %v", err))
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read data file: %v", err)
+	}
+	data := new(res.BehaviorTreesData)
+	err = unmarshal(buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal data: %v", err)
+	}
+	return data.Trees, nil
+}
+
+// ImportBehaviorTreesDir imports all behavior trees data from
+// files in directory with specified path.
+func ImportBehaviorTreesDir(path string) ([]res.BehaviorTreeData, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to read dir: %v", err))
+	}
+	trees := make([]res.BehaviorTreeData, 0)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		filePath := filepath.FromSlash(path + "/" + file.Name())
+		impTrees, err := ImportBehaviorTrees(filePath)
+		if err != nil {
+			log.Err.Printf("data: import behavior trees dir: %s: unable to parse behavior trees file: %v",
+				filePath, err)
+			continue
+		}
+		trees = append(trees, impTrees...)
+	}
+	return trees, nil
+}
+
+// ExportBehaviorTrees saves behavior trees to new file with
+// specified path.
+func ExportBehaviorTrees(path string, trees ...res.BehaviorTreeData) error {
+	data := new(res.BehaviorTreesData)
+	data.Trees = append(data.Trees, trees...)
+	// Marshal behavior trees data.
+	buf, err := marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal behavior trees: %v", err)
+	}
+	dirPath := filepath.Dir(path)
+	err = os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create behavior trees file directory: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create behavior trees file: %v", err)
+	}
+	defer file.Close()
+	// Write data to file.
+	w := bufio.NewWriter(file)
+	w.Write(buf)
+	w.Flush()
+	return nil
+}
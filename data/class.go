@@ -0,0 +1,108 @@
+/*
+ * class.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package data
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// ImportClasses imports all classes from file with specified path.
+func ImportClasses(path string) ([]res.ClassData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to open data file: %v", err))
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read data file: %v", err)
+	}
+	data := new(res.ClassesData)
+	err = unmarshal(buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal JSON data: %v", err)
+	}
+	return data.Classes, nil
+}
+
+// ImportClassesDir imports all classes from data files from
+// directory with specified path.
+func ImportClassesDir(path string) ([]res.ClassData, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to read dir: %v", err))
+	}
+	classes := make([]res.ClassData, 0)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		filePath := filepath.Join(path, file.Name())
+		impClasses, err := ImportClasses(filePath)
+		if err != nil {
+			log.Err.Printf("data: import classes dir: %s: unable to import file: %v",
+				filePath, err)
+			continue
+		}
+		classes = append(classes, impClasses...)
+	}
+	return classes, nil
+}
+
+// ExportClasses exports classes to data file under specified path.
+func ExportClasses(path string, classes ...res.ClassData) error {
+	data := new(res.ClassesData)
+	for _, c := range classes {
+		data.Classes = append(data.Classes, c)
+	}
+	// Marshal classes data.
+	json, err := marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal classes: %v", err)
+	}
+	// Create classes file.
+	dirPath := filepath.Dir(path)
+	err = os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create classes file directory: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create classes file: %v", err)
+	}
+	defer file.Close()
+	// Write data to file.
+	writer := bufio.NewWriter(file)
+	writer.Write(json)
+	writer.Flush()
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * event.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package data
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// ImportEvents imports world events data from base file
+// with specified path.
+func ImportEvents(path string) ([]res.EventData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to open data file: %v", err))
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read data file: %v", err)
+	}
+	data := new(res.EventsData)
+	err = unmarshal(buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal data: %v", err)
+	}
+	return data.Events, nil
+}
+
+// ImportEventsDir imports all world events data from
+// files in directory with specified path.
+func ImportEventsDir(path string) ([]res.EventData, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to read dir: %v", err))
+	}
+	events := make([]res.EventData, 0)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		filePath := filepath.FromSlash(path + "/" + file.Name())
+		impEvents, err := ImportEvents(filePath)
+		if err != nil {
+			log.Err.Printf("data: import events dir: %s: unable to parse events file: %v",
+				filePath, err)
+			continue
+		}
+		events = append(events, impEvents...)
+	}
+	return events, nil
+}
+
+// ExportEvents saves world events to new file with
+// specified path.
+func ExportEvents(path string, events ...res.EventData) error {
+	data := new(res.EventsData)
+	data.Events = append(data.Events, events...)
+	// Marshal events data.
+	buf, err := marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal events: %v", err)
+	}
+	dirPath := filepath.Dir(path)
+	err = os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create events file directory: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create events file: %v", err)
+	}
+	defer file.Close()
+	// Write data to file.
+	w := bufio.NewWriter(file)
+	w.Write(buf)
+	w.Flush()
+	return nil
+}
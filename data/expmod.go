@@ -1,7 +1,7 @@
 /*
  * expmod.go
  *
- * Copyright 2020-2024 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2020-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -77,12 +77,42 @@ func ExportModuleDir(path string, data res.ModuleData) error {
 	if err != nil {
 		return fmt.Errorf("unable to export characters: %v", err)
 	}
+	// Objects.
+	objectsPath := filepath.Join(path, "objects", "main")
+	err = ExportObjects(objectsPath, data.Resources.Objects...)
+	if err != nil {
+		return fmt.Errorf("unable to export objects: %v", err)
+	}
 	// Races.
 	racesPath := filepath.Join(path, "races", "main")
 	err = ExportRaces(racesPath, data.Resources.Races...)
 	if err != nil {
 		return fmt.Errorf("unable to export races: %v", err)
 	}
+	// Classes.
+	classesPath := filepath.Join(path, "classes", "main")
+	err = ExportClasses(classesPath, data.Resources.Classes...)
+	if err != nil {
+		return fmt.Errorf("unable to export classes: %v", err)
+	}
+	// Factions.
+	factionsPath := filepath.Join(path, "factions", "main")
+	err = ExportFactions(factionsPath, data.Resources.Factions...)
+	if err != nil {
+		return fmt.Errorf("unable to export factions: %v", err)
+	}
+	// Levels.
+	levelsPath := filepath.Join(path, "levels", "main")
+	err = ExportLevels(levelsPath, data.Resources.Levels...)
+	if err != nil {
+		return fmt.Errorf("unable to export levels: %v", err)
+	}
+	// Behavior trees.
+	behaviorsPath := filepath.Join(path, "behaviors", "main")
+	err = ExportBehaviorTrees(behaviorsPath, data.Resources.BehaviorTrees...)
+	if err != nil {
+		return fmt.Errorf("unable to export behavior trees: %v", err)
+	}
 	// Skills.
 	skillsPath := filepath.Join(path, "skills", "main")
 	err = ExportSkills(skillsPath, data.Resources.Skills...)
@@ -159,6 +189,18 @@ func exportChapterDir(path string, data res.ChapterData) error {
 	if err != nil {
 		return fmt.Errorf("unable to export characters: %v", err)
 	}
+	// Objects.
+	objectsPath := filepath.Join(path, "objects", "main")
+	err = ExportObjects(objectsPath, data.Resources.Objects...)
+	if err != nil {
+		return fmt.Errorf("unable to export objects: %v", err)
+	}
+	// Behavior trees.
+	behaviorsPath := filepath.Join(path, "behaviors", "main")
+	err = ExportBehaviorTrees(behaviorsPath, data.Resources.BehaviorTrees...)
+	if err != nil {
+		return fmt.Errorf("unable to export behavior trees: %v", err)
+	}
 	// Quests.
 	questsPath := filepath.Join(path, "quests", "main")
 	err = ExportQuests(questsPath, data.Resources.Quests...)
@@ -186,6 +228,12 @@ func exportChapterDir(path string, data res.ChapterData) error {
 	if err != nil {
 		return fmt.Errorf("unable to export translations: %v", err)
 	}
+	// Events.
+	eventsPath := filepath.Join(path, "events", "main")
+	err = ExportEvents(eventsPath, data.Events...)
+	if err != nil {
+		return fmt.Errorf("unable to export events: %v", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,108 @@
+/*
+ * faction.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package data
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// ImportFactions imports all factions from file with specified path.
+func ImportFactions(path string) ([]res.FactionData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to open data file: %v", err))
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read data file: %v", err)
+	}
+	data := new(res.FactionsData)
+	err = unmarshal(buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal JSON data: %v", err)
+	}
+	return data.Factions, nil
+}
+
+// ImportFactionsDir imports all factions from data files from
+// directory with specified path.
+func ImportFactionsDir(path string) ([]res.FactionData, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to read dir: %v", err))
+	}
+	factions := make([]res.FactionData, 0)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		filePath := filepath.Join(path, file.Name())
+		impFactions, err := ImportFactions(filePath)
+		if err != nil {
+			log.Err.Printf("data: import factions dir: %s: unable to import file: %v",
+				filePath, err)
+			continue
+		}
+		factions = append(factions, impFactions...)
+	}
+	return factions, nil
+}
+
+// ExportFactions exports factions to data file under specified path.
+func ExportFactions(path string, factions ...res.FactionData) error {
+	data := new(res.FactionsData)
+	for _, f := range factions {
+		data.Factions = append(data.Factions, f)
+	}
+	// Marshal factions data.
+	json, err := marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal factions: %v", err)
+	}
+	// Create factions file.
+	dirPath := filepath.Dir(path)
+	err = os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create factions file directory: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create factions file: %v", err)
+	}
+	defer file.Close()
+	// Write data to file.
+	writer := bufio.NewWriter(file)
+	writer.Write(json)
+	writer.Flush()
+	return nil
+}
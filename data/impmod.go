@@ -1,7 +1,7 @@
 /*
  * impmod.go
  *
- * Copyright 2018-2024 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2018-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -81,11 +81,36 @@ func ImportModuleDir(path string) (data res.ModuleData, err error) {
 	if isExistingDataError(err) {
 		log.Err.Printf("Import module: unable to import characters: %v", err)
 	}
+	// Objects.
+	data.Resources.Objects, err = ImportObjectsDir(filepath.Join(path, "objects"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import module: unable to import objects: %v", err)
+	}
 	// Races.
 	data.Resources.Races, err = ImportRacesDir(filepath.Join(path, "races"))
 	if isExistingDataError(err) {
 		log.Err.Printf("Import module: unable to imports races: %v", err)
 	}
+	// Classes.
+	data.Resources.Classes, err = ImportClassesDir(filepath.Join(path, "classes"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import module: unable to import classes: %v", err)
+	}
+	// Factions.
+	data.Resources.Factions, err = ImportFactionsDir(filepath.Join(path, "factions"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import module: unable to import factions: %v", err)
+	}
+	// Levels.
+	data.Resources.Levels, err = ImportLevelsDir(filepath.Join(path, "levels"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import module: unable to import levels: %v", err)
+	}
+	// Behavior trees.
+	data.Resources.BehaviorTrees, err = ImportBehaviorTreesDir(filepath.Join(path, "behaviors"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import module: unable to import behavior trees: %v", err)
+	}
 	// Skills.
 	data.Resources.Skills, err = ImportSkillsDir(filepath.Join(path, "skills"))
 	if isExistingDataError(err) {
@@ -157,6 +182,16 @@ func ImportChapterDir(path string) (data res.ChapterData, err error) {
 	if isExistingDataError(err) {
 		log.Err.Printf("Import chapter: unable to import characters: %v", err)
 	}
+	// Objects.
+	data.Resources.Objects, err = ImportObjectsDir(filepath.Join(path, "objects"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import chapter: unable to import objects: %v", err)
+	}
+	// Behavior trees.
+	data.Resources.BehaviorTrees, err = ImportBehaviorTreesDir(filepath.Join(path, "behaviors"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import chapter: unable to import behavior trees: %v", err)
+	}
 	// Quests.
 	data.Resources.Quests, err = ImportQuestsDir(filepath.Join(path, "quests"))
 	if isExistingDataError(err) {
@@ -177,5 +212,10 @@ func ImportChapterDir(path string) (data res.ChapterData, err error) {
 	if isExistingDataError(err) {
 		log.Err.Printf("Import chapter: unable to import translations: %v", err)
 	}
+	// Events.
+	data.Events, err = ImportEventsDir(filepath.Join(path, "events"))
+	if isExistingDataError(err) {
+		log.Err.Printf("Import chapter: unable to import events: %v", err)
+	}
 	return data, nil
 }
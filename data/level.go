@@ -0,0 +1,108 @@
+/*
+ * level.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package data
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+)
+
+// ImportLevels imports all levels from file with specified path.
+func ImportLevels(path string) ([]res.LevelData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to open data file: %v", err))
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read data file: %v", err)
+	}
+	data := new(res.LevelsData)
+	err = unmarshal(buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal JSON data: %v", err)
+	}
+	return data.Levels, nil
+}
+
+// ImportLevelsDir imports all levels from data files from
+// directory with specified path.
+func ImportLevelsDir(path string) ([]res.LevelData, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("unable to read dir: %v", err))
+	}
+	levels := make([]res.LevelData, 0)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		filePath := filepath.Join(path, file.Name())
+		impLevels, err := ImportLevels(filePath)
+		if err != nil {
+			log.Err.Printf("data: import levels dir: %s: unable to import file: %v",
+				filePath, err)
+			continue
+		}
+		levels = append(levels, impLevels...)
+	}
+	return levels, nil
+}
+
+// ExportLevels exports levels to data file under specified path.
+func ExportLevels(path string, levels ...res.LevelData) error {
+	data := new(res.LevelsData)
+	for _, l := range levels {
+		data.Levels = append(data.Levels, l)
+	}
+	// Marshal levels data.
+	json, err := marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal levels: %v", err)
+	}
+	// Create levels file.
+	dirPath := filepath.Dir(path)
+	err = os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create levels file directory: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create levels file: %v", err)
+	}
+	defer file.Close()
+	// Write data to file.
+	writer := bufio.NewWriter(file)
+	writer.Write(json)
+	writer.Flush()
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+ * object_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package data
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+)
+
+// TestExportImportObjects tests exporting and importing
+// area objects.
+func TestExportImportObjects(t *testing.T) {
+	// Create data
+	data := res.ObjectData{
+		ID:     "chest",
+		State:  "closed",
+		Radius: 5,
+		States: []res.ObjectStateData{
+			{ID: "closed"},
+			{ID: "open", Passable: true},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "testobjects")
+	// Test
+	err := ExportObjects(path, data)
+	if err != nil {
+		t.Fatalf("Unable to export objects: %v", err)
+	}
+	objects, err := ImportObjects(path)
+	if err != nil {
+		t.Fatalf("Unable to import objects: %v", err)
+	}
+	if len(objects) != 1 {
+		t.Fatalf("Invalid number of imported objects: %d != 1", len(objects))
+	}
+	if objects[0].ID != data.ID || objects[0].State != data.State {
+		t.Errorf("Invalid imported object: %s %s != %s %s", objects[0].ID,
+			objects[0].State, data.ID, data.State)
+	}
+	if len(objects[0].States) != 2 || !objects[0].States[1].Passable {
+		t.Errorf("Invalid imported object states: %v", objects[0].States)
+	}
+}
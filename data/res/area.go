@@ -30,15 +30,28 @@ import (
 )
 
 // Struct for area data.
+// Item despawn set to 0 means default item despawn time
+// and negative value means that item piles are never
+// removed from the area.
 type AreaData struct {
-	XMLName    xml.Name         `xml:"area" json:"-"`
-	ID         string           `xml:"id,attr" json:"id"`
-	Time       string           `xml:"time,attr" json:"time"`
-	Weather    string           `xml:"weather,attr" json:"weather"`
-	Map        *tmx.Map         `xml:"map" json:"map"`
-	Spawn      SpawnData        `xml:"spawn" json:"spawn"`
-	Characters []AreaCharData   `xml:"characters>character" json:"characters"`
-	Subareas   []AreaData       `xml:"subareas>area" json:"subareas"`
+	XMLName      xml.Name                `xml:"area" json:"-"`
+	ID           string                  `xml:"id,attr" json:"id"`
+	Time         string                  `xml:"time,attr" json:"time"`
+	Weather      string                  `xml:"weather,attr" json:"weather"`
+	WeatherTime  int64                   `xml:"weather-time,attr" json:"weather-time"`
+	Season       string                  `xml:"season,attr" json:"season"`
+	Restore      bool                    `xml:"restore,attr" json:"restore"`
+	Map          *tmx.Map                `xml:"map" json:"map"`
+	Spawn        SpawnData               `xml:"spawn" json:"spawn"`
+	Characters   []AreaCharData          `xml:"characters>character" json:"characters"`
+	Objects      []ObjectData            `xml:"objects>object" json:"objects"`
+	SpawnPoints  []SpawnPointData        `xml:"spawn-points>point" json:"spawn-points"`
+	Portals      []PortalData            `xml:"portals>portal" json:"portals"`
+	Triggers     []TriggerData           `xml:"triggers>trigger" json:"triggers"`
+	ItemDespawn  int64                   `xml:"item-despawn,attr" json:"item-despawn"`
+	ItemPiles    []ItemPileData          `xml:"item-piles>pile" json:"item-piles"`
+	Subareas     []AreaData              `xml:"subareas>area" json:"subareas"`
+	WeatherTable []WeatherConditionsData `xml:"weather-table>conditions" json:"weather-table"`
 }
 
 // Struct for area character data.
@@ -63,10 +76,123 @@ type AreaCharData struct {
 type SpawnData struct {
 	RespawnQueue []SpawnObject `xml:"respawn-queue" json:"respawn-queue"`
 	DespawnQueue []SpawnObject `xml:"despawn-queue" json:"despawn-queue"`
+	Spawners     []SpawnerData `xml:"spawner" json:"spawners"`
 }
 
 // Struct for area respawn object data.
+// Time is the time left to respawn or despawn
+// in milliseconds.
 type SpawnObject struct {
 	SerialObjectData
 	Time int64
 }
+
+// Struct for area spawner data.
+type SpawnerData struct {
+	ID         string             `xml:"id,attr" json:"id"`
+	Interval   int64              `xml:"interval,attr" json:"interval"`
+	Max        int                `xml:"max,attr" json:"max"`
+	Despawn    int64              `xml:"despawn,attr" json:"despawn"`
+	Time       int64              `xml:"time,attr" json:"time"`
+	Region     RegionData         `xml:"region" json:"region"`
+	Characters []SpawnerCharData  `xml:"characters>character" json:"characters"`
+	Spawned    []SerialObjectData `xml:"spawned>object" json:"spawned"`
+}
+
+// Struct for data of character spawned by area spawner.
+type SpawnerCharData struct {
+	ID     string `xml:"id,attr" json:"id"`
+	Weight int    `xml:"weight,attr" json:"weight"`
+}
+
+// Struct for area spawn point data.
+type SpawnPointData struct {
+	ID string  `xml:"id,attr" json:"id"`
+	X  float64 `xml:"x,attr" json:"x"`
+	Y  float64 `xml:"y,attr" json:"y"`
+}
+
+// Struct for area portal data.
+type PortalData struct {
+	ID           string     `xml:"id,attr" json:"id"`
+	DestArea     string     `xml:"dest-area,attr" json:"dest-area"`
+	DestX        float64    `xml:"dest-x,attr" json:"dest-x"`
+	DestY        float64    `xml:"dest-y,attr" json:"dest-y"`
+	Region       RegionData `xml:"region" json:"region"`
+	Requirements ReqsData   `xml:"reqs" json:"reqs"`
+	Lock         LockData   `xml:"lock" json:"lock"`
+}
+
+// Struct for area weather conditions data.
+type WeatherConditionsData struct {
+	ID          string                  `xml:"id,attr" json:"id"`
+	Weight      int                     `xml:"weight,attr" json:"weight"`
+	MinDuration int64                   `xml:"min-duration,attr" json:"min-duration"`
+	MaxDuration int64                   `xml:"max-duration,attr" json:"max-duration"`
+	Seasons     []WeatherSeasonData     `xml:"seasons>season" json:"seasons"`
+	Transitions []WeatherTransitionData `xml:"transitions>transition" json:"transitions"`
+	Mods        ModifiersData           `xml:"modifiers" json:"modifiers"`
+	Effects     []UseActionEffectData   `xml:"effects>effect" json:"effects"`
+}
+
+// Struct for weather season data.
+type WeatherSeasonData struct {
+	ID string `xml:"id,attr" json:"id"`
+}
+
+// Struct for weather transition data.
+type WeatherTransitionData struct {
+	ID     string `xml:"id,attr" json:"id"`
+	Weight int    `xml:"weight,attr" json:"weight"`
+}
+
+// Struct for area item pile data.
+type ItemPileData struct {
+	Serial    string        `xml:"serial,attr" json:"serial"`
+	PosX      float64       `xml:"x,attr" json:"pos-x"`
+	PosY      float64       `xml:"y,attr" json:"pos-y"`
+	Despawn   int64         `xml:"despawn,attr" json:"despawn"`
+	Inventory InventoryData `xml:"inventory" json:"inventory"`
+}
+
+// Struct for area trigger data.
+type TriggerData struct {
+	ID           string                `xml:"id,attr" json:"id"`
+	Once         bool                  `xml:"once,attr" json:"once"`
+	Fired        bool                  `xml:"fired,attr" json:"fired"`
+	StayInterval int64                 `xml:"stay-interval,attr" json:"stay-interval"`
+	Region       RegionData            `xml:"region" json:"region"`
+	Requirements ReqsData              `xml:"reqs" json:"reqs"`
+	EnterMods    ModifiersData         `xml:"enter>modifiers" json:"enter-mods"`
+	LeaveMods    ModifiersData         `xml:"leave>modifiers" json:"leave-mods"`
+	StayMods     ModifiersData         `xml:"stay>modifiers" json:"stay-mods"`
+	EnterEffects []UseActionEffectData `xml:"enter>effects>effect" json:"enter-effects"`
+	LeaveEffects []UseActionEffectData `xml:"leave>effects>effect" json:"leave-effects"`
+	StayEffects  []UseActionEffectData `xml:"stay>effects>effect" json:"stay-effects"`
+	Objects      []TriggerObjectData   `xml:"objects>object" json:"objects"`
+}
+
+// Struct for data of object inside area trigger.
+type TriggerObjectData struct {
+	ID     string `xml:"id,attr" json:"id"`
+	Serial string `xml:"serial,attr" json:"serial"`
+	Time   int64  `xml:"time,attr" json:"time"`
+}
+
+// Struct for area region data.
+// Region is a polygon if there are at least
+// three points specified, otherwise region is
+// a rectangle.
+type RegionData struct {
+	X      float64     `xml:"x,attr" json:"x"`
+	Y      float64     `xml:"y,attr" json:"y"`
+	Width  float64     `xml:"width,attr" json:"width"`
+	Height float64     `xml:"height,attr" json:"height"`
+	Points []PointData `xml:"point" json:"points"`
+}
+
+// Struct for XY point data.
+type PointData struct {
+	X float64 `xml:"x,attr" json:"x"`
+	Y float64 `xml:"y,attr" json:"y"`
+}
@@ -0,0 +1,47 @@
+/*
+ * area_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+// TestSpawnObjectXml tests spawn object XML mappings.
+func TestSpawnObjectXml(t *testing.T) {
+	// Create test object
+	data := []byte(`<respawn-queue id="char" serial="0"><Time>1000</Time></respawn-queue>`)
+	ob := new(SpawnObject)
+	err := xml.Unmarshal(data, ob)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal XML data: %v", err)
+	}
+	// Test
+	if ob.ID != "char" {
+		t.Errorf("Invalid ID: %s != 'char'", ob.ID)
+	}
+	if ob.Time != 1000 {
+		t.Errorf("Invalid time: %d != 1000", ob.Time)
+	}
+}
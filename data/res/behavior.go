@@ -0,0 +1,60 @@
+/*
+ * behavior.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+import (
+	"encoding/xml"
+)
+
+// Struct for behavior trees data.
+type BehaviorTreesData struct {
+	XMLName xml.Name           `xml:"behavior-trees" json:"-"`
+	Trees   []BehaviorTreeData `xml:"tree" json:"trees"`
+}
+
+// Struct for behavior tree data.
+type BehaviorTreeData struct {
+	ID   string           `xml:"id,attr" json:"id"`
+	Root BehaviorNodeData `xml:"node" json:"root"`
+}
+
+// Struct for behavior tree node data.
+type BehaviorNodeData struct {
+	Type      string             `xml:"type,attr" json:"type"`
+	PosX      float64            `xml:"position-x,attr" json:"pos-x"`
+	PosY      float64            `xml:"position-y,attr" json:"pos-y"`
+	Skill     string             `xml:"skill,attr" json:"skill"`
+	Text      string             `xml:"text,attr" json:"text"`
+	Time      int64              `xml:"time,attr" json:"time"`
+	Target    bool               `xml:"target,attr" json:"target"`
+	Reqs      ReqsData           `xml:"reqs" json:"reqs"`
+	Modifiers ModifiersData      `xml:"modifiers" json:"modifiers"`
+	Nodes     []BehaviorNodeData `xml:"node" json:"nodes"`
+}
+
+// Struct for behavior tree node state data.
+type BehaviorStateData struct {
+	Child int   `xml:"child,attr" json:"child"`
+	Time  int64 `xml:"time,attr" json:"time"`
+}
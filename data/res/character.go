@@ -1,7 +1,7 @@
 /*
  * character.go
  *
- * Copyright 2019-2025 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2019-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -41,6 +41,7 @@ type CharacterData struct {
 	Level          int                   `xml:"level,attr" json:"level"`
 	Sex            string                `xml:"gender,attr" json:"sex"`
 	Race           string                `xml:"race,attr" json:"race"`
+	Class          string                `xml:"class,attr" json:"class"`
 	Attitude       string                `xml:"attitude,attr" json:"attitude"`
 	Guild          string                `xml:"guild,attr" json:"guild"`
 	Alignment      string                `xml:"alignment,attr" json:"alignment"`
@@ -53,6 +54,7 @@ type CharacterData struct {
 	HP             int                   `xml:"hp,attr" json:"hp"`
 	Mana           int                   `xml:"mana,attr" json:"mana"`
 	Exp            int                   `xml:"exp,attr" json:"exp"`
+	AttrPoints     int                   `xml:"attribute-points,attr" json:"attribute-points"`
 	Restore        bool                  `xml:"restore,attr" json:"restore"`
 	OpenLoot       bool                  `xml:"open-loot,attr" json:"open-loot"`
 	Action         UseActionData         `xml:"action" json:"action"`
@@ -60,7 +62,10 @@ type CharacterData struct {
 	Chapter        string                `xml:"chapter,attr" json:"chapter"`
 	UseCooldown    int64                 `xml:"use-cooldown,attr" json:"use-cooldown"`
 	MoveCooldown   int64                 `xml:"move-cooldown,attr" json:"move-cooldown"`
+	Radius         float64               `xml:"collision-radius,attr" json:"collision-radius"`
+	NonBlocking    bool                  `xml:"non-blocking,attr" json:"non-blocking"`
 	Attributes     AttributesData        `xml:"attributes" json:"attributes"`
+	SpentAttrs     AttributesData        `xml:"spent-attributes" json:"spent-attributes"`
 	Inventory      InventoryData         `xml:"inventory" json:"inventory"`
 	Equipment      EquipmentData         `xml:"equipment" json:"equipment"`
 	QuestLog       QuestLogData          `xml:"quests" json:"quests"`
@@ -74,8 +79,22 @@ type CharacterData struct {
 	Effects        []ObjectEffectData    `xml:"effects>effect" json:"effects"`
 	Skills         []ObjectSkillData     `xml:"skills>skill" json:"skills"`
 	Memory         []AttitudeMemoryData  `xml:"memory>target" json:"memory"`
+	Threat         []ThreatData          `xml:"threat>target" json:"threat"`
+	CombatTime     int64                 `xml:"combat-time,attr" json:"combat-time"`
+	MemoryTime     int64                 `xml:"memory-time,attr" json:"memory-time"`
+	HelpRange      float64               `xml:"help-range,attr" json:"help-range"`
+	Reputation     []ReputationData      `xml:"reputation>faction" json:"reputation"`
+	TradeReqs      ReqsData              `xml:"trade>reqs" json:"trade-reqs"`
+	Owner          SerialObjectData      `xml:"owner" json:"owner"`
+	Summons        []SerialObjectData    `xml:"summons>summon" json:"summons"`
+	Lifetime       int64                 `xml:"lifetime,attr" json:"lifetime"`
+	Command        string                `xml:"command,attr" json:"command"`
 	Dialogs        []ObjectDialogData    `xml:"dialogs>dialog" json:"dialogs"`
 	StartedDialogs []ObjectDialogData    `xml:"started-dialogs>dialog" json:"started-dialogs"`
+	Schedule       []ScheduleEntryData   `xml:"schedule>entry" json:"schedule"`
+	ScheduleEntry  string                `xml:"schedule-entry,attr" json:"schedule-entry"`
+	BehaviorTree   string                `xml:"behavior-tree,attr" json:"behavior-tree"`
+	TreeState      []BehaviorStateData   `xml:"behavior-tree-state>node" json:"behavior-tree-state"`
 }
 
 // Struct for character attributes data.
@@ -106,6 +125,28 @@ type AttitudeMemoryData struct {
 	ObjectID     string `xml:"id,attr" json:"id"`
 	ObjectSerial string `xml:"serial,attr" json:"serial"`
 	Attitude     string `xml:"attitude,attr" json:"attitude"`
+	Time         int64  `xml:"time,attr" json:"time"`
+	Duration     int64  `xml:"duration,attr" json:"duration"`
+}
+
+// Struct for character schedule entry data.
+type ScheduleEntryData struct {
+	ID      string             `xml:"id,attr" json:"id"`
+	Hour    int                `xml:"hour,attr" json:"hour"`
+	Minute  int                `xml:"minute,attr" json:"minute"`
+	Area    string             `xml:"area,attr" json:"area"`
+	PosX    float64            `xml:"position-x,attr" json:"pos-x"`
+	PosY    float64            `xml:"position-y,attr" json:"pos-y"`
+	NoTrade bool               `xml:"no-trade,attr" json:"no-trade"`
+	Dialogs []ObjectDialogData `xml:"dialogs>dialog" json:"dialogs"`
+	Flags   []FlagData         `xml:"flags>flag" json:"flags"`
+}
+
+// Struct for threat table entry data.
+type ThreatData struct {
+	ObjectID     string  `xml:"id,attr" json:"id"`
+	ObjectSerial string  `xml:"serial,attr" json:"serial"`
+	Value        float64 `xml:"value,attr" json:"value"`
 }
 
 // Struct for data of usable object casted by character.
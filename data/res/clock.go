@@ -0,0 +1,52 @@
+/*
+ * clock.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+// Struct for world clock data.
+type ClockData struct {
+	Time      int64               `xml:"time,attr" json:"time"`
+	Scale     float64             `xml:"scale,attr" json:"scale"`
+	WeekDays  []CalendarDayData   `xml:"week-days>day" json:"week-days"`
+	Months    []CalendarMonthData `xml:"months>month" json:"months"`
+	DayPhases []DayPhaseData      `xml:"day-phases>phase" json:"day-phases"`
+}
+
+// Struct for calendar day data.
+type CalendarDayData struct {
+	ID string `xml:"id,attr" json:"id"`
+}
+
+// Struct for calendar month data.
+type CalendarMonthData struct {
+	ID     string `xml:"id,attr" json:"id"`
+	Days   int    `xml:"days,attr" json:"days"`
+	Season string `xml:"season,attr" json:"season"`
+}
+
+// Struct for day phase data.
+type DayPhaseData struct {
+	ID    string  `xml:"id,attr" json:"id"`
+	Start int     `xml:"start,attr" json:"start"`
+	Sight float64 `xml:"sight,attr" json:"sight"`
+}
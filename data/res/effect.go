@@ -61,6 +61,11 @@ type ModifiersData struct {
 	MemoryMods       []MemoryModData       `xml:"memory-mod" json:"memory-mods"`
 	MoveSpeedMods    []ValueModData        `xml:"move-speed-mod" json:"move-speed-mods"`
 	VisibilityMods   []ValueModData        `xml:"visibility-mod" json:"visibility-mods"`
+	StateMods        []StateModData        `xml:"state-mod" json:"state-mods"`
+	SightMods        []ValueModData        `xml:"sight-mod" json:"sight-mods"`
+	TauntMods        []ValueModData        `xml:"taunt-mod" json:"taunt-mods"`
+	ReputationMods   []ReputationModData   `xml:"reputation-mod" json:"reputation-mods"`
+	SummonMods       []SummonModData       `xml:"summon-mod" json:"summon-mods"`
 }
 
 // Struct for health modifier data.
@@ -123,6 +128,26 @@ type AttributeModData AttributesData
 // Struct for memory modifier data.
 type MemoryModData struct {
 	Attitude string `xml:"attitude,attr" json:"attitude"`
+	Duration int64  `xml:"duration,attr" json:"duration"`
+}
+
+// Struct for reputation modifier data.
+type ReputationModData struct {
+	Faction string `xml:"faction,attr" json:"faction"`
+	Value   int    `xml:"value,attr" json:"value"`
+}
+
+// Struct for summon modifier data.
+type SummonModData struct {
+	ID      string `xml:"id,attr" json:"id"`
+	Time    int64  `xml:"time,attr" json:"time"`
+	Max     int    `xml:"max,attr" json:"max"`
+	Command string `xml:"command,attr" json:"command"`
+}
+
+// Struct for state modifier data.
+type StateModData struct {
+	State string `xml:"state,attr" json:"state"`
 }
 
 // Struct for generic value modifier data.
@@ -0,0 +1,62 @@
+/*
+ * event.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+import (
+	"encoding/xml"
+)
+
+// Struct for world events data.
+type EventsData struct {
+	XMLName xml.Name    `xml:"events" json:"-"`
+	Events  []EventData `xml:"event" json:"events"`
+}
+
+// Struct for world event data.
+type EventData struct {
+	ID        string             `xml:"id,attr" json:"id"`
+	Area      string             `xml:"area,attr" json:"area"`
+	Interval  int64              `xml:"interval,attr" json:"interval"`
+	Days      int                `xml:"days,attr" json:"days"`
+	Hour      int                `xml:"hour,attr" json:"hour"`
+	Minute    int                `xml:"minute,attr" json:"minute"`
+	Duration  int64              `xml:"duration,attr" json:"duration"`
+	Restore   bool               `xml:"restore,attr" json:"restore"`
+	Active    bool               `xml:"active,attr" json:"active"`
+	Next      int64              `xml:"next,attr" json:"next"`
+	End       int64              `xml:"end,attr" json:"end"`
+	Spawns    []EventSpawnData   `xml:"spawns>spawn" json:"spawns"`
+	Flags     []FlagData         `xml:"flags>flag" json:"flags"`
+	Modifiers ModifiersData      `xml:"modifiers" json:"modifiers"`
+	Spawned   []SerialObjectData `xml:"spawned>object" json:"spawned"`
+	Affected  []SerialObjectData `xml:"affected>object" json:"affected"`
+}
+
+// Struct for data of characters spawned by world event.
+type EventSpawnData struct {
+	ID     string  `xml:"id,attr" json:"id"`
+	Amount int     `xml:"amount,attr" json:"amount"`
+	PosX   float64 `xml:"position-x,attr" json:"pos-x"`
+	PosY   float64 `xml:"position-y,attr" json:"pos-y"`
+}
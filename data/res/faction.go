@@ -0,0 +1,55 @@
+/*
+ * faction.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+import (
+	"encoding/xml"
+)
+
+// Struct for factions data.
+type FactionsData struct {
+	XMLName  xml.Name      `xml:"factions" json:"-"`
+	Factions []FactionData `xml:"faction" json:"factions"`
+}
+
+// Struct for faction data.
+// Reputation thresholds are nil if not specified.
+type FactionData struct {
+	ID        string                `xml:"id,attr" json:"id"`
+	Hostile   *int                  `xml:"hostile,attr" json:"hostile,omitempty"`
+	Friendly  *int                  `xml:"friendly,attr" json:"friendly,omitempty"`
+	Relations []FactionRelationData `xml:"relations>relation" json:"relations"`
+}
+
+// Struct for faction relation data.
+type FactionRelationData struct {
+	Faction string `xml:"faction,attr" json:"faction"`
+	Value   int    `xml:"value,attr" json:"value"`
+}
+
+// Struct for character reputation data.
+type ReputationData struct {
+	Faction string `xml:"faction,attr" json:"faction"`
+	Value   int    `xml:"value,attr" json:"value"`
+}
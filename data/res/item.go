@@ -97,6 +97,7 @@ type ItemSlotData struct {
 // Struct for inventory data.
 type InventoryData struct {
 	Items []InventoryItemData `xml:"item" json:"items"`
+	Lock  LockData            `xml:"lock" json:"lock"`
 }
 
 // Struct for inventory item data
@@ -0,0 +1,45 @@
+/*
+ * level.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+import (
+	"encoding/xml"
+)
+
+// Struct for levels data.
+type LevelsData struct {
+	XMLName xml.Name    `xml:"levels" json:"-"`
+	Levels  []LevelData `xml:"level" json:"levels"`
+}
+
+// Struct for character level data.
+type LevelData struct {
+	Level      int               `xml:"level,attr" json:"level"`
+	Exp        int               `xml:"exp,attr" json:"exp"`
+	KillExp    int               `xml:"kill-exp,attr" json:"kill-exp"`
+	AttrPoints int               `xml:"attribute-points,attr" json:"attribute-points"`
+	Health     int               `xml:"health,attr" json:"health"`
+	Mana       int               `xml:"mana,attr" json:"mana"`
+	Skills     []ObjectSkillData `xml:"skills>skill" json:"skills"`
+}
@@ -0,0 +1,36 @@
+/*
+ * lock.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+// Struct for lock data.
+type LockData struct {
+	Locked      bool                  `xml:"locked,attr" json:"locked"`
+	Key         string                `xml:"key,attr" json:"key"`
+	Flag        string                `xml:"flag,attr" json:"flag"`
+	Difficulty  int                   `xml:"difficulty,attr" json:"difficulty"`
+	Relock      int64                 `xml:"relock,attr" json:"relock"`
+	RelockTime  int64                 `xml:"relock-time,attr" json:"relock-time"`
+	FailMods    ModifiersData         `xml:"fail>modifiers" json:"fail-mods"`
+	FailEffects []UseActionEffectData `xml:"fail>effects>effect" json:"fail-effects"`
+}
@@ -1,7 +1,7 @@
 /*
  * module.go
  *
- * Copyright 2020-2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2020-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -29,6 +29,7 @@ type ModuleData struct {
 	Config    map[string][]string `xml:"config" json:"config"`
 	Chapter   ChapterData         `xml:"chapter" json:"chapter"`
 	Resources ResourcesData       `xml:"resources" json:"resources"`
+	Parties   []PartyData         `xml:"parties>party" json:"parties"`
 }
 
 // Struct for chapter data.
@@ -36,11 +37,13 @@ type ChapterData struct {
 	ID        string              `xml:"id,attr" json:"id"`
 	Config    map[string][]string `xml:"config" json:"config"`
 	Resources ResourcesData       `xml:"resources" json:"resources"`
+	Events    []EventData         `xml:"events>event" json:"events"`
 }
 
 // Struct for module resouces data.
 type ResourcesData struct {
 	Characters       []CharacterData       `xml:"characters>character" json:"characters"`
+	Objects          []ObjectData          `xml:"objects>object" json:"objects"`
 	Effects          []EffectData          `xml:"effects>effect" json:"effects"`
 	Skills           []SkillData           `xml:"skills>skill" json:"skills"`
 	Armors           []ArmorData           `xml:"armors>armor" json:"armors"`
@@ -51,6 +54,10 @@ type ResourcesData struct {
 	Recipes          []RecipeData          `xml:"recipes>recipe" json:"recipes"`
 	Areas            []AreaData            `xml:"areas>area" json:"areas"`
 	Races            []RaceData            `xml:"races>race" json:"races"`
+	Classes          []ClassData           `xml:"classes>class" json:"classes"`
+	Factions         []FactionData         `xml:"factions>faction" json:"factions"`
+	Levels           []LevelData           `xml:"levels>level" json:"levels"`
+	BehaviorTrees    []BehaviorTreeData    `xml:"behavior-trees>tree" json:"behavior-trees"`
 	Trainings        []TrainingData        `xml:"trainings>training" json:"trainings"`
 	TranslationBases []TranslationBaseData `xml:"translations>base" json:"translation-base"`
 }
@@ -24,9 +24,40 @@
 package res
 
 import (
+	"encoding/xml"
 	"time"
 )
 
+// Struct for area objects data.
+type ObjectsData struct {
+	XMLName xml.Name     `xml:"objects" json:"-"`
+	Objects []ObjectData `xml:"object" json:"objects"`
+}
+
+// Struct for area object data.
+type ObjectData struct {
+	ID        string             `xml:"id,attr" json:"id"`
+	Serial    string             `xml:"serial,attr" json:"serial"`
+	State     string             `xml:"state,attr" json:"state"`
+	PosX      float64            `xml:"position-x,attr" json:"pos-x"`
+	PosY      float64            `xml:"position-y,attr" json:"pos-y"`
+	Area      string             `xml:"area,attr" json:"area"`
+	Radius    float64            `xml:"collision-radius,attr" json:"collision-radius"`
+	OpenLoot  bool               `xml:"open-loot,attr" json:"open-loot"`
+	Restore   bool               `xml:"restore,attr" json:"restore"`
+	Action    UseActionData      `xml:"action" json:"action"`
+	States    []ObjectStateData  `xml:"states>state" json:"states"`
+	Inventory InventoryData      `xml:"inventory" json:"inventory"`
+	Effects   []ObjectEffectData `xml:"effects>effect" json:"effects"`
+}
+
+// Struct for area object state data.
+type ObjectStateData struct {
+	ID       string        `xml:"id,attr" json:"id"`
+	Passable bool          `xml:"passable,attr" json:"passable"`
+	Action   UseActionData `xml:"action" json:"action"`
+}
+
 // Struct for object effects data.
 type ObjectEffectData struct {
 	ID           string `xml:"id,attr" json:"id"`
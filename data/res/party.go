@@ -0,0 +1,39 @@
+/*
+ * party.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package res
+
+// Struct for party data.
+type PartyData struct {
+	ID      string             `xml:"id,attr" json:"id"`
+	Max     int                `xml:"max,attr" json:"max"`
+	Spacing float64            `xml:"spacing,attr" json:"spacing"`
+	Members []SerialObjectData `xml:"members>member" json:"members"`
+	Invites []SerialObjectData `xml:"invites>invite" json:"invites"`
+}
+
+// Struct for party requirement data.
+type PartyReqData struct {
+	Members int  `xml:"members,attr" json:"members"`
+	Area    bool `xml:"area,attr" json:"area"`
+}
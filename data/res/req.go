@@ -33,6 +33,7 @@ type ReqsData struct {
 	LevelReqs         []LevelReqData       `xml:"level-req" json:"level-reqs"`
 	GenderReqs        []GenderReqData      `xml:"gender-req" json:"gender-reqs"`
 	FlagReqs          []IDReqData          `xml:"flag-req" json:"flag-reqs"`
+	WorldFlagReqs     []IDReqData          `xml:"world-flag-req" json:"world-flag-reqs"`
 	ItemReqs          []ItemReqData        `xml:"item-req" json:"item-reqs"`
 	CurrencyReqs      []ValueReqData       `xml:"currency-req" json:"currency-reqs"`
 	TargetRangeReqs   []TargetRangeReqData `xml:"target-range-req" json:"target-range-reqs"`
@@ -45,6 +46,24 @@ type ReqsData struct {
 	CombatReqs        []CombatReqData      `xml:"combat-req" json:"combat-reqs"`
 	VisibilityReqs    []ValueReqData       `xml:"visibility-req" json:"visibility-reqs"`
 	EffectReqs        []IDReqData          `xml:"effect-req" json:"effect-reqs"`
+	TimeOfDayReqs     []TimeOfDayReqData   `xml:"time-of-day-req" json:"time-of-day-reqs"`
+	ReputationReqs    []ReputationReqData  `xml:"reputation-req" json:"reputation-reqs"`
+	PartyReqs         []PartyReqData       `xml:"party-req" json:"party-reqs"`
+	ClassReqs         []IDReqData          `xml:"class-req" json:"class-reqs"`
+}
+
+// Struct for time of day requirement data.
+type TimeOfDayReqData struct {
+	Phase string `xml:"phase,attr" json:"phase"`
+	From  int    `xml:"from,attr" json:"from"`
+	To    int    `xml:"to,attr" json:"to"`
+}
+
+// Struct for reputation requirement data.
+type ReputationReqData struct {
+	Faction string `xml:"faction,attr" json:"faction"`
+	Value   int    `xml:"value,attr" json:"value"`
+	Less    bool   `xml:"less,attr" json:"less"`
 }
 
 // Struct for level requirement data.
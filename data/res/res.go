@@ -1,7 +1,7 @@
 /*
  * res.go
  *
- * Copyright 2019-2023 Dariusz Sikora <dev@isangeles.pl>
+ * Copyright 2019-2026 Dariusz Sikora <dev@isangeles.pl>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -30,11 +30,16 @@ var (
 	Weapons          []WeaponData
 	Miscs            []MiscItemData
 	Characters       []CharacterData
+	Objects          []ObjectData
 	Dialogs          []DialogData
 	Quests           []QuestData
 	Recipes          []RecipeData
 	Areas            []AreaData
 	Races            []RaceData
+	Classes          []ClassData
+	Factions         []FactionData
+	Levels           []LevelData
+	BehaviorTrees    []BehaviorTreeData
 	Trainings        []TrainingData
 	TranslationBases []*TranslationBaseData
 )
@@ -119,6 +124,16 @@ func Character(id, serial string) *CharacterData {
 	return nil
 }
 
+// Object returns area object data for specified ID.
+func Object(id string) *ObjectData {
+	for _, d := range Objects {
+		if d.ID == id {
+			return &d
+		}
+	}
+	return nil
+}
+
 // Dialog returns dialog data for specified ID.
 func Dialog(id string) *DialogData {
 	for _, d := range Dialogs {
@@ -169,6 +184,46 @@ func Race(id string) *RaceData {
 	return nil
 }
 
+// Class returns class data for specified ID.
+func Class(id string) *ClassData {
+	for _, d := range Classes {
+		if d.ID == id {
+			return &d
+		}
+	}
+	return nil
+}
+
+// Faction returns faction data for specified ID.
+func Faction(id string) *FactionData {
+	for _, d := range Factions {
+		if d.ID == id {
+			return &d
+		}
+	}
+	return nil
+}
+
+// Level returns data for specified character level.
+func Level(level int) *LevelData {
+	for _, d := range Levels {
+		if d.Level == level {
+			return &d
+		}
+	}
+	return nil
+}
+
+// BehaviorTree returns behavior tree data for specified ID.
+func BehaviorTree(id string) *BehaviorTreeData {
+	for _, d := range BehaviorTrees {
+		if d.ID == id {
+			return &d
+		}
+	}
+	return nil
+}
+
 // Training returns training data for specified ID.
 func Training(id string) *TrainingData {
 	for _, d := range Trainings {
@@ -197,11 +252,16 @@ func Clear() {
 	Weapons = make([]WeaponData, 0)
 	Miscs = make([]MiscItemData, 0)
 	Characters = make([]CharacterData, 0)
+	Objects = make([]ObjectData, 0)
 	Dialogs = make([]DialogData, 0)
 	Quests = make([]QuestData, 0)
 	Recipes = make([]RecipeData, 0)
 	Areas = make([]AreaData, 0)
 	Races = make([]RaceData, 0)
+	Classes = make([]ClassData, 0)
+	Factions = make([]FactionData, 0)
+	Levels = make([]LevelData, 0)
+	BehaviorTrees = make([]BehaviorTreeData, 0)
 	Trainings = make([]TrainingData, 0)
 	TranslationBases = make([]*TranslationBaseData, 0)
 }
@@ -210,7 +270,12 @@ func Clear() {
 // to resources base.
 func Add(r ResourcesData) {
 	Characters = append(Characters, r.Characters...)
+	Objects = append(Objects, r.Objects...)
 	Races = append(Races, r.Races...)
+	Classes = append(Classes, r.Classes...)
+	Factions = append(Factions, r.Factions...)
+	Levels = append(Levels, r.Levels...)
+	BehaviorTrees = append(BehaviorTrees, r.BehaviorTrees...)
 	Effects = append(Effects, r.Effects...)
 	Skills = append(Skills, r.Skills...)
 	Armors = append(Armors, r.Armors...)
@@ -30,11 +30,12 @@ import (
 // Struct for memory modifier.
 type MemoryMod struct {
 	attitude string
+	duration int64
 }
 
 // NewMemoryModifer creates new memory modifer.
 func NewMemoryMod(data res.MemoryModData) *MemoryMod {
-	mm := MemoryMod{data.Attitude}
+	mm := MemoryMod{data.Attitude, data.Duration}
 	return &mm
 }
 
@@ -43,7 +44,12 @@ func (mm *MemoryMod) Attitude() string {
 	return mm.attitude
 }
 
+// Duration returns duration of the memory in milliseconds.
+func (mm *MemoryMod) Duration() int64 {
+	return mm.duration
+}
+
 // Data returns data resource for modifier.
 func (mm *MemoryMod) Data() res.MemoryModData {
-	return res.MemoryModData{mm.attitude}
+	return res.MemoryModData{mm.attitude, mm.duration}
 }
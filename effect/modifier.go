@@ -88,6 +88,26 @@ func NewModifiers(data res.ModifiersData) (mods []Modifier) {
 		visibilityMod := NewVisibilityMod(md)
 		mods = append(mods, visibilityMod)
 	}
+	for _, md := range data.StateMods {
+		stateMod := NewStateMod(md)
+		mods = append(mods, stateMod)
+	}
+	for _, md := range data.SightMods {
+		sightMod := NewSightMod(md)
+		mods = append(mods, sightMod)
+	}
+	for _, md := range data.TauntMods {
+		tauntMod := NewTauntMod(md)
+		mods = append(mods, tauntMod)
+	}
+	for _, md := range data.ReputationMods {
+		reputationMod := NewReputationMod(md)
+		mods = append(mods, reputationMod)
+	}
+	for _, md := range data.SummonMods {
+		summonMod := NewSummonMod(md)
+		mods = append(mods, summonMod)
+	}
 	return
 }
 
@@ -123,6 +143,16 @@ func ModifiersData(mods ...Modifier) (data res.ModifiersData) {
 			data.MoveSpeedMods = append(data.MoveSpeedMods, m.Data())
 		case *VisibilityMod:
 			data.VisibilityMods = append(data.VisibilityMods, m.Data())
+		case *StateMod:
+			data.StateMods = append(data.StateMods, m.Data())
+		case *SightMod:
+			data.SightMods = append(data.SightMods, m.Data())
+		case *TauntMod:
+			data.TauntMods = append(data.TauntMods, m.Data())
+		case *ReputationMod:
+			data.ReputationMods = append(data.ReputationMods, m.Data())
+		case *SummonMod:
+			data.SummonMods = append(data.SummonMods, m.Data())
 		}
 	}
 	return
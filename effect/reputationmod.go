@@ -0,0 +1,55 @@
+/*
+ * reputationmod.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package effect
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for reputation modifier.
+type ReputationMod struct {
+	faction string
+	value   int
+}
+
+// NewReputationMod creates new reputation modifier.
+func NewReputationMod(data res.ReputationModData) *ReputationMod {
+	rm := ReputationMod{data.Faction, data.Value}
+	return &rm
+}
+
+// FactionID returns ID of the faction to modify reputation with.
+func (rm *ReputationMod) FactionID() string {
+	return rm.faction
+}
+
+// Value returns reputation value to add.
+func (rm *ReputationMod) Value() int {
+	return rm.value
+}
+
+// Data returns data resource for the modifier.
+func (rm *ReputationMod) Data() res.ReputationModData {
+	return res.ReputationModData{rm.faction, rm.value}
+}
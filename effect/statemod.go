@@ -0,0 +1,48 @@
+/*
+ * statemod.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package effect
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for state modifier.
+type StateMod struct {
+	state string
+}
+
+// NewStateMod creates new state modifier.
+func NewStateMod(data res.StateModData) *StateMod {
+	return &StateMod{data.State}
+}
+
+// State returns ID of state to change to.
+func (sm *StateMod) State() string {
+	return sm.state
+}
+
+// Data returns data resource for state modifier.
+func (sm *StateMod) Data() res.StateModData {
+	return res.StateModData{sm.State()}
+}
@@ -0,0 +1,70 @@
+/*
+ * summonmod.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package effect
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for summon modifier.
+type SummonMod struct {
+	charID  string
+	time    int64
+	max     int
+	command string
+}
+
+// NewSummonMod creates new summon modifier.
+func NewSummonMod(data res.SummonModData) *SummonMod {
+	sm := SummonMod{data.ID, data.Time, data.Max, data.Command}
+	return &sm
+}
+
+// CharacterID returns ID of the character to summon.
+func (sm *SummonMod) CharacterID() string {
+	return sm.charID
+}
+
+// Time returns lifetime of the summoned character
+// in milliseconds, zero means no time limit.
+func (sm *SummonMod) Time() int64 {
+	return sm.time
+}
+
+// Max returns maximal number of summoned characters
+// with the same ID, zero means no limit.
+func (sm *SummonMod) Max() int {
+	return sm.max
+}
+
+// Command returns initial command for the summoned
+// character.
+func (sm *SummonMod) Command() string {
+	return sm.command
+}
+
+// Data returns data resource for the modifier.
+func (sm *SummonMod) Data() res.SummonModData {
+	return res.SummonModData{sm.charID, sm.time, sm.max, sm.command}
+}
@@ -0,0 +1,49 @@
+/*
+ * tauntmod.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package effect
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for taunt modifier.
+type TauntMod struct {
+	value float64
+}
+
+// NewTauntMod creates new taunt modifier.
+func NewTauntMod(data res.ValueModData) *TauntMod {
+	tm := TauntMod{value: float64(data.Value)}
+	return &tm
+}
+
+// Value returns the threat value of the modifier.
+func (tm *TauntMod) Value() float64 {
+	return tm.value
+}
+
+// Data returns data resource for the modifier.
+func (tm *TauntMod) Data() res.ValueModData {
+	return res.ValueModData{int64(tm.value)}
+}
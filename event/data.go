@@ -0,0 +1,86 @@
+/*
+ * data.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package event
+
+import (
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/flag"
+	"github.com/isangeles/flame/log"
+)
+
+// Apply applies specified data on the event.
+func (e *Event) Apply(data res.EventData) {
+	e.id = data.ID
+	e.areaID = data.Area
+	e.interval = data.Interval
+	e.days = data.Days
+	e.hour = data.Hour
+	e.minute = data.Minute
+	e.duration = data.Duration
+	if e.duration < 1 {
+		log.Err.Printf("Event: %s: apply: invalid duration: %d", e.ID(), e.duration)
+	}
+	e.spawns = data.Spawns
+	e.modsData = data.Modifiers
+	e.mods = effect.NewModifiers(data.Modifiers)
+	e.flags = nil
+	for _, fd := range data.Flags {
+		e.flags = append(e.flags, flag.Flag(fd.ID))
+	}
+	if !data.Restore {
+		return
+	}
+	e.scheduled = true
+	e.active = data.Active
+	e.next = data.Next
+	e.end = data.End
+	e.spawned = data.Spawned
+	e.affected = data.Affected
+}
+
+// Data returns data resource for event.
+func (e *Event) Data() res.EventData {
+	data := res.EventData{
+		ID:        e.ID(),
+		Area:      e.AreaID(),
+		Interval:  e.interval,
+		Days:      e.days,
+		Hour:      e.hour,
+		Minute:    e.minute,
+		Duration:  e.duration,
+		Restore:   e.scheduled,
+		Active:    e.Active(),
+		Next:      e.Next(),
+		End:       e.End(),
+		Spawns:    e.spawns,
+		Modifiers: e.modsData,
+		Spawned:   e.spawned,
+		Affected:  e.affected,
+	}
+	for _, f := range e.flags {
+		data.Flags = append(data.Flags, res.FlagData{f.ID()})
+	}
+	return data
+}
@@ -0,0 +1,268 @@
+/*
+ * event.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+// Package for scheduled world events.
+package event
+
+import (
+	"github.com/isangeles/flame/area"
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/clock"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/flag"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/serial"
+)
+
+// Interface for world where events take place.
+type World interface {
+	Clock() *clock.Clock
+	Area(id string) *area.Area
+	AreaObject(id, serial string) area.Object
+	ObjectArea(ob area.Object) *area.Area
+	AddFlag(f flag.Flag)
+	RemoveFlag(f flag.Flag)
+}
+
+// Struct for world event.
+type Event struct {
+	id        string
+	areaID    string
+	interval  int64 // millis
+	days      int
+	hour      int
+	minute    int
+	duration  int64 // millis
+	scheduled bool
+	active    bool
+	next      int64
+	end       int64
+	spawns    []res.EventSpawnData
+	flags     []flag.Flag
+	modsData  res.ModifiersData
+	mods      []effect.Modifier
+	spawned   []res.SerialObjectData
+	affected  []res.SerialObjectData
+}
+
+// New creates new world event.
+func New(data res.EventData) *Event {
+	e := new(Event)
+	e.Apply(data)
+	return e
+}
+
+// Update starts or ends event, depending on the
+// current time of the world clock.
+// Events without duration are invalid and never start.
+func (e *Event) Update(w World) {
+	if w.Clock() == nil || e.duration < 1 {
+		return
+	}
+	now := w.Clock().Time()
+	if !e.scheduled {
+		e.schedule(now)
+	}
+	if e.active && now >= e.end {
+		e.stop(w)
+	}
+	if !e.active && now >= e.next {
+		e.start(w, now)
+		return
+	}
+	if e.active {
+		e.affect(w)
+	}
+}
+
+// ID returns event ID.
+func (e *Event) ID() string {
+	return e.id
+}
+
+// AreaID returns ID of the event area.
+func (e *Event) AreaID() string {
+	return e.areaID
+}
+
+// Active checks if event is currently in progress.
+func (e *Event) Active() bool {
+	return e.active
+}
+
+// Next returns world time of the next event start.
+func (e *Event) Next() int64 {
+	return e.next
+}
+
+// End returns world time of the end of the active
+// event.
+func (e *Event) End() int64 {
+	return e.end
+}
+
+// start starts event.
+// Sets event flags, applies event modifiers on all characters
+// in the event area and spawns event characters controlled
+// by AI.
+// Characters entering the event area while the event is
+// active receive event modifiers on the next update.
+func (e *Event) start(w World, now int64) {
+	e.active = true
+	e.end = now + e.duration
+	e.advance(now)
+	for _, f := range e.flags {
+		w.AddFlag(f)
+	}
+	if len(e.spawns) < 1 && len(e.mods) < 1 {
+		return
+	}
+	a := w.Area(e.areaID)
+	if a == nil {
+		log.Err.Printf("Event: %s: start: area not found: %s", e.ID(), e.areaID)
+		return
+	}
+	e.affect(w)
+	for _, s := range e.spawns {
+		charData := res.Character(s.ID, "")
+		if charData == nil {
+			log.Err.Printf("Event: %s: start: character data not found: %s",
+				e.ID(), s.ID)
+			continue
+		}
+		for i := 0; i < s.Amount || i < 1; i++ {
+			char := character.New(*charData)
+			char.SetPosition(s.PosX, s.PosY)
+			char.SetDefaultPosition(s.PosX, s.PosY)
+			char.SetAI(true)
+			a.AddObject(char)
+			e.spawned = append(e.spawned, res.SerialObjectData{char.ID(), char.Serial()})
+		}
+	}
+}
+
+// stop ends event and removes everything
+// set by the event.
+func (e *Event) stop(w World) {
+	e.active = false
+	for _, f := range e.flags {
+		w.RemoveFlag(f)
+	}
+	for _, sd := range e.affected {
+		char, ok := w.AreaObject(sd.ID, sd.Serial).(*character.Character)
+		if ok {
+			char.RemoveModifiers(nil, e.mods...)
+		}
+	}
+	for _, sd := range e.spawned {
+		ob := w.AreaObject(sd.ID, sd.Serial)
+		if ob == nil {
+			continue
+		}
+		if a := w.ObjectArea(ob); a != nil {
+			a.RemoveObject(ob)
+		}
+		serial.Unregister(ob)
+	}
+	e.affected = nil
+	e.spawned = nil
+}
+
+// affect applies event modifiers on all characters in the
+// event area, that were not affected or spawned by the event.
+func (e *Event) affect(w World) {
+	if len(e.mods) < 1 {
+		return
+	}
+	a := w.Area(e.areaID)
+	if a == nil {
+		return
+	}
+	for _, ob := range a.AllObjects() {
+		char, ok := ob.(*character.Character)
+		if !ok || contains(e.affected, char) || contains(e.spawned, char) {
+			continue
+		}
+		char.TakeModifiers(nil, e.mods...)
+		e.affected = append(e.affected, res.SerialObjectData{char.ID(), char.Serial()})
+	}
+}
+
+// contains checks if specified list contains
+// specified character.
+func contains(list []res.SerialObjectData, char *character.Character) bool {
+	for _, sd := range list {
+		if sd.ID == char.ID() && sd.Serial == char.Serial() {
+			return true
+		}
+	}
+	return false
+}
+
+// schedule sets the first start time of the event
+// after specified world time.
+func (e *Event) schedule(now int64) {
+	e.scheduled = true
+	if e.interval > 0 {
+		e.next = now + e.interval
+		return
+	}
+	day := now / clock.DayLength
+	if now < 0 && now%clock.DayLength != 0 {
+		day--
+	}
+	day -= (day%e.period() + e.period()) % e.period()
+	e.next = day*clock.DayLength + e.timeOfDay()
+	for e.next < now {
+		e.next += e.period() * clock.DayLength
+	}
+}
+
+// advance moves next start time of the event after
+// specified world time.
+func (e *Event) advance(now int64) {
+	if e.interval > 0 {
+		e.next += e.interval
+		if e.next <= now {
+			e.next = now + e.interval
+		}
+		return
+	}
+	for e.next <= now {
+		e.next += e.period() * clock.DayLength
+	}
+}
+
+// period returns number of days between event starts.
+func (e *Event) period() int64 {
+	if e.days < 1 {
+		return 1
+	}
+	return int64(e.days)
+}
+
+// timeOfDay returns event start time of day(in milliseconds).
+func (e *Event) timeOfDay() int64 {
+	return int64(e.hour)*clock.HourLength + int64(e.minute)*clock.HourLength/60
+}
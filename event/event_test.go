@@ -0,0 +1,220 @@
+/*
+ * event_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package event
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/area"
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/clock"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/flag"
+	"github.com/isangeles/flame/serial"
+)
+
+var (
+	charData = res.CharacterData{ID: "char", Level: 1, Attributes: res.AttributesData{5, 5, 5, 5, 5}}
+	raidData = res.EventData{
+		ID:        "raid",
+		Area:      "area",
+		Hour:      0,
+		Days:      7,
+		Duration:  clock.HourLength,
+		Spawns:    []res.EventSpawnData{{ID: "bandit", Amount: 2, PosX: 10, PosY: 10}},
+		Flags:     []res.FlagData{{"raid"}},
+		Modifiers: res.ModifiersData{FlagMods: []res.FlagModData{{ID: "scared"}}},
+	}
+)
+
+// TestEventUpdate tests starting and ending events
+// on update.
+func TestEventUpdate(t *testing.T) {
+	// Create test objects
+	res.Add(res.ResourcesData{Characters: []res.CharacterData{{ID: "bandit", Level: 1}}})
+	w := newTestWorld()
+	char := character.New(charData)
+	w.area.AddObject(char)
+	w.clock.SetTime(clock.HourLength)
+	e := New(raidData)
+	// Test
+	e.Update(w)
+	if e.Active() {
+		t.Fatalf("Event should not be active")
+	}
+	if e.Next() != 7*clock.DayLength {
+		t.Errorf("Invalid next event start: %d != %d", e.Next(), 7*clock.DayLength)
+	}
+	w.clock.SetTime(7*clock.DayLength + 1)
+	e.Update(w)
+	if !e.Active() {
+		t.Fatalf("Event should be active")
+	}
+	if !w.flags[raidData.Flags[0].ID] {
+		t.Errorf("Event world flag not set")
+	}
+	if !char.HasFlag(flag.Flag("scared")) {
+		t.Errorf("Event modifiers not applied")
+	}
+	if len(w.area.Objects()) != 3 {
+		t.Errorf("Invalid number of area objects: %d != 3", len(w.area.Objects()))
+	}
+	spawned := e.spawned
+	for _, ob := range w.area.Objects() {
+		if ob.ID() == "bandit" && ob.(*character.Character).HasFlag(flag.Flag("scared")) {
+			t.Errorf("Event modifiers applied on spawned character")
+		}
+	}
+	lateChar := character.New(charData)
+	w.area.AddObject(lateChar)
+	e.Update(w)
+	if !lateChar.HasFlag(flag.Flag("scared")) {
+		t.Errorf("Event modifiers not applied on late character")
+	}
+	w.clock.SetTime(7*clock.DayLength + 2*clock.HourLength)
+	e.Update(w)
+	if e.Active() {
+		t.Fatalf("Event should not be active")
+	}
+	if w.flags[raidData.Flags[0].ID] {
+		t.Errorf("Event world flag not removed")
+	}
+	if char.HasFlag(flag.Flag("scared")) || lateChar.HasFlag(flag.Flag("scared")) {
+		t.Errorf("Event modifiers not removed")
+	}
+	if len(w.area.Objects()) != 2 {
+		t.Errorf("Invalid number of area objects: %d != 2", len(w.area.Objects()))
+	}
+	for _, sd := range spawned {
+		if serial.Object(sd.ID, sd.Serial) != nil {
+			t.Errorf("Spawned character not unregistered: %s %s", sd.ID, sd.Serial)
+		}
+	}
+	if e.Next() != 14*clock.DayLength {
+		t.Errorf("Invalid next event start: %d != %d", e.Next(), 14*clock.DayLength)
+	}
+}
+
+// TestEventInterval tests interval events.
+func TestEventInterval(t *testing.T) {
+	// Create test objects
+	w := newTestWorld()
+	e := New(res.EventData{ID: "caravan", Interval: 100, Duration: 10})
+	// Test
+	e.Update(w)
+	if e.Next() != 100 {
+		t.Fatalf("Invalid next event start: %d != 100", e.Next())
+	}
+	w.clock.SetTime(150)
+	e.Update(w)
+	if !e.Active() {
+		t.Errorf("Event should be active")
+	}
+	if e.Next() != 200 {
+		t.Errorf("Invalid next event start: %d != 200", e.Next())
+	}
+	w.clock.SetTime(160)
+	e.Update(w)
+	if e.Active() {
+		t.Errorf("Event should not be active")
+	}
+}
+
+// TestEventNoDuration tests events without duration.
+func TestEventNoDuration(t *testing.T) {
+	// Create test objects
+	w := newTestWorld()
+	e := New(res.EventData{ID: "caravan", Interval: 100})
+	// Test
+	w.clock.SetTime(150)
+	e.Update(w)
+	if e.Active() {
+		t.Errorf("Event without duration should not be active")
+	}
+}
+
+// TestEventData tests event data restore.
+func TestEventData(t *testing.T) {
+	// Create test objects
+	res.Add(res.ResourcesData{Characters: []res.CharacterData{{ID: "bandit", Level: 1}}})
+	w := newTestWorld()
+	w.clock.SetTime(7 * clock.DayLength)
+	e := New(raidData)
+	e.Update(w)
+	// Test
+	data := e.Data()
+	if !data.Restore || !data.Active || len(data.Spawned) != 2 {
+		t.Fatalf("Invalid event data: %v", data)
+	}
+	e = New(data)
+	w.clock.SetTime(7*clock.DayLength + clock.HourLength)
+	e.Update(w)
+	if e.Active() {
+		t.Errorf("Event should not be active")
+	}
+	if len(w.area.Objects()) != 0 {
+		t.Errorf("Invalid number of area objects: %d != 0", len(w.area.Objects()))
+	}
+}
+
+// Struct for test world.
+type testWorld struct {
+	clock *clock.Clock
+	area  *area.Area
+	flags map[string]bool
+}
+
+// newTestWorld creates new test world.
+func newTestWorld() *testWorld {
+	w := testWorld{
+		clock: clock.New(res.ClockData{}),
+		area:  area.New(res.AreaData{ID: "area"}),
+		flags: make(map[string]bool),
+	}
+	return &w
+}
+
+func (tw *testWorld) Clock() *clock.Clock { return tw.clock }
+
+func (tw *testWorld) Area(id string) *area.Area {
+	if tw.area.ID() == id {
+		return tw.area
+	}
+	return nil
+}
+
+func (tw *testWorld) AreaObject(id, serial string) area.Object {
+	for _, ob := range tw.area.AllObjects() {
+		if ob.ID() == id && ob.Serial() == serial {
+			return ob
+		}
+	}
+	return nil
+}
+
+func (tw *testWorld) ObjectArea(ob area.Object) *area.Area { return tw.area }
+
+func (tw *testWorld) AddFlag(f flag.Flag) { tw.flags[f.ID()] = true }
+
+func (tw *testWorld) RemoveFlag(f flag.Flag) { delete(tw.flags, f.ID()) }
@@ -58,6 +58,7 @@ func main() {
 		Wis:       6,
 	}
 	pc := character.New(pcData)
+	mod.Chapter().GrantStartAttrs(pc)
 	// Add PC to start area and set position.
 	chapterConf := mod.Chapter().Conf()
 	startArea := mod.Chapter().Area(chapterConf.StartArea)
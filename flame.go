@@ -27,6 +27,7 @@ package flame
 import (
 	"github.com/isangeles/flame/character"
 	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/party"
 	"github.com/isangeles/flame/serial"
 )
 
@@ -39,6 +40,7 @@ type Module struct {
 	res                   *res.ResourcesData
 	conf                  *ModuleConfig
 	chapter               *Chapter
+	parties               []*party.Party
 	changeChapterEvents []func(ob *character.Character)
 }
 
@@ -56,6 +58,15 @@ func (m *Module) Update(delta int64) {
 		return
 	}
 	m.Chapter().Update(delta)
+	parties := make([]*party.Party, 0, len(m.parties))
+	for _, p := range m.parties {
+		if p.Disbanded() {
+			continue
+		}
+		p.Update(delta)
+		parties = append(parties, p)
+	}
+	m.parties = parties
 	for _, c := range m.Chapter().Characters() {
 		if len(c.ChapterID()) > 0 && c.ChapterID() != m.Chapter().ID() {
 			for _, ev := range m.changeChapterEvents {
@@ -94,6 +105,29 @@ func (m *Module) Object(id, serial string) serial.Serialer {
 	return nil
 }
 
+// Parties returns all module parties.
+func (m *Module) Parties() []*party.Party {
+	return m.parties
+}
+
+// Party returns party with specified ID or nil if
+// no such party was found.
+func (m *Module) Party(id string) *party.Party {
+	for _, p := range m.parties {
+		if p.ID() == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// AddParty adds specified party to the module.
+// Disbanded parties are removed from the module
+// on update.
+func (m *Module) AddParty(p *party.Party) {
+	m.parties = append(m.parties, p)
+}
+
 // Resources returns module resources.
 func (m *Module) Resources() *res.ResourcesData {
 	return m.res
@@ -123,9 +157,17 @@ func (m *Module) Apply(data res.ModuleData) {
 	if m.Chapter() == nil || m.Chapter().Conf().ID != data.Chapter.ID {
 		chapter := NewChapter(m, data.Chapter)
 		m.SetChapter(chapter)
-		return
+	} else {
+		m.Chapter().Apply(data.Chapter)
+	}
+	// Parties.
+	for _, p := range m.parties {
+		p.Disband()
+	}
+	m.parties = make([]*party.Party, 0)
+	for _, pd := range data.Parties {
+		m.AddParty(party.New(pd))
 	}
-	m.Chapter().Apply(data.Chapter)
 }
 
 // Data creates data resource for module.
@@ -137,6 +179,9 @@ func (m *Module) Data() res.ModuleData {
 	data.Config["chapter"] = []string{m.Chapter().Conf().ID}
 	data.Chapter = m.Chapter().Data()
 	data.Resources = *m.res
+	for _, p := range m.Parties() {
+		data.Parties = append(data.Parties, p.Data())
+	}
 	// Remove old characters from resources, besides basic ones.
 	data.Resources.Characters = make([]res.CharacterData, 0)
 	for _, c := range m.Resources().Characters {
@@ -1,7 +1,7 @@
 /*
  * flame_test.go
  *
- * Copyright 2023-2024 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2023-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -24,10 +24,15 @@
 package flame
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/clock"
 	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/flag"
+	"github.com/isangeles/flame/party"
+	"github.com/isangeles/flame/req"
 )
 
 var (
@@ -65,3 +70,159 @@ func TestChapterChangeEvent(t *testing.T) {
 		t.Errorf("Event was not triggered")
 	}
 }
+
+// TestChapterWorldFlags tests checking chapter world
+// flags with requirements.
+func TestChapterWorldFlags(t *testing.T) {
+	// Create test objects
+	mod := NewModule(modData)
+	area := mod.Chapter().Area("area")
+	if area == nil {
+		t.Fatalf("Test area not found")
+	}
+	char := character.New(charData)
+	area.AddObject(char)
+	flagReq := req.NewWorldFlag(res.IDReqData{ID: "flagRaid"})
+	// Test
+	if char.MeetReqs(flagReq) {
+		t.Errorf("World flag requirement meet without flag")
+	}
+	mod.Chapter().AddFlag(flag.Flag("flagRaid"))
+	if !char.MeetReqs(flagReq) {
+		t.Errorf("World flag requirement not meet")
+	}
+}
+
+// TestChapterStartAttrs tests granting start attribute
+// points to new characters.
+func TestChapterStartAttrs(t *testing.T) {
+	// Create test objects
+	data := chapterData
+	data.Config = map[string][]string{"start-attrs": {"5"}}
+	mod := NewModule(res.ModuleData{ID: "module", Chapter: data})
+	char := character.New(res.CharacterData{ID: "char", AttrPoints: 1})
+	// Test
+	mod.Chapter().GrantStartAttrs(char)
+	if char.AttributePoints() != 6 {
+		t.Errorf("Invalid attribute points: %d != 6", char.AttributePoints())
+	}
+}
+
+// TestChapterPortal tests moving characters between
+// areas and subareas through area portals.
+func TestChapterPortal(t *testing.T) {
+	// Create test objects
+	portalData := res.PortalData{
+		ID:       "portal",
+		DestArea: "subarea",
+		DestX:    100,
+		DestY:    100,
+		Region:   res.RegionData{Width: 10, Height: 10},
+	}
+	subareaData := res.AreaData{ID: "subarea"}
+	areaData := res.AreaData{
+		ID:       "portalArea",
+		Portals:  []res.PortalData{portalData},
+		Subareas: []res.AreaData{subareaData},
+	}
+	chapterData := res.ChapterData{ID: "chapter"}
+	chapterData.Resources.Areas = []res.AreaData{areaData}
+	mod := NewModule(res.ModuleData{ID: "module", Chapter: chapterData})
+	area := mod.Chapter().Area("portalArea")
+	if area == nil {
+		t.Fatalf("Test area not found")
+	}
+	ob := character.New(charData)
+	ob.SetPosition(20, 20)
+	area.AddObject(ob)
+	// Test
+	ob.SetPosition(5, 5)
+	mod.Update(1)
+	obArea := mod.Chapter().ObjectArea(ob)
+	if obArea == nil || obArea.ID() != "subarea" {
+		t.Fatalf("Character not moved to the portal destination area")
+	}
+	x, y := ob.Position()
+	if x != 100 || y != 100 {
+		t.Errorf("Invalid character position: %f %f != 100 100", x, y)
+	}
+}
+
+// TestChapterClock tests chapter world clock shared
+// between chapter areas.
+func TestChapterClock(t *testing.T) {
+	// Create test objects
+	chapterData := res.ChapterData{ID: "chapter"}
+	chapterData.Config = map[string][]string{
+		"time":             {fmt.Sprintf("%d", clock.HourLength*6)},
+		"time-scale":       {"2"},
+		"day-phases":       {"day", "night"},
+		"day-phases-start": {"6", "20"},
+		"day-phases-sight": {"0", "-100"},
+	}
+	chapterData.Resources.Areas = []res.AreaData{
+		{ID: "area1", Subareas: []res.AreaData{{ID: "subarea"}}},
+		{ID: "area2"},
+	}
+	mod := NewModule(res.ModuleData{ID: "module", Chapter: chapterData})
+	chapter := mod.Chapter()
+	ob := character.New(charData)
+	chapter.Area("area1").AddObject(ob)
+	sight := ob.SightRange()
+	// Test
+	mod.Update(clock.HourLength * 7)
+	for _, a := range chapter.Areas() {
+		if a.Clock() != chapter.Clock() {
+			t.Errorf("Area clock is not chapter clock: %s", a.ID())
+		}
+		for _, sa := range a.Subareas() {
+			if sa.Clock() != chapter.Clock() {
+				t.Errorf("Subarea clock is not chapter clock: %s", sa.ID())
+			}
+		}
+	}
+	if chapter.Clock().Hour() != 20 {
+		t.Errorf("Invalid clock hour: %d != 20", chapter.Clock().Hour())
+	}
+	if ob.SightRange() != sight-100 {
+		t.Errorf("Invalid sight range at night: %f != %f", ob.SightRange(), sight-100)
+	}
+	data := chapter.Data()
+	time := fmt.Sprintf("%d", chapter.Clock().Time())
+	if len(data.Config["time"]) < 1 || data.Config["time"][0] != time {
+		t.Errorf("Invalid clock time in chapter data: %v", data.Config["time"])
+	}
+}
+
+// TestModuleParties tests saving and restoring module
+// parties.
+func TestModuleParties(t *testing.T) {
+	// Create test objects
+	mod := NewModule(modData)
+	char := character.New(charData)
+	p := party.New(res.PartyData{ID: "party"})
+	p.Join(char)
+	mod.AddParty(p)
+	// Test
+	mod.Update(1)
+	data := mod.Data()
+	if len(data.Parties) != 1 {
+		t.Fatalf("Invalid number of saved parties: %d != 1", len(data.Parties))
+	}
+	mod.Apply(data)
+	if mod.Party("party") == nil || char.Party() != mod.Party("party") {
+		t.Errorf("Party not restored")
+	}
+	mod.Party("party").Leave(char)
+	mod.Update(1)
+	if len(mod.Parties()) > 0 {
+		t.Errorf("Empty party not removed")
+	}
+	invited := party.New(res.PartyData{ID: "invited"})
+	invited.Invite(char)
+	mod.AddParty(invited)
+	mod.Update(1)
+	if mod.Party("invited") == nil {
+		t.Errorf("Party with pending invites removed")
+	}
+}
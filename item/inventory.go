@@ -1,7 +1,7 @@
 /*
  * inventory.go
  *
- * Copyright 2018-2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2018-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -28,6 +28,7 @@ import (
 	"sync"
 
 	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/lock"
 	"github.com/isangeles/flame/log"
 	"github.com/isangeles/flame/rng"
 	"github.com/isangeles/flame/serial"
@@ -36,6 +37,7 @@ import (
 // Struct for container with items.
 type Inventory struct {
 	items         *sync.Map
+	lock          *lock.Lock
 	onItemRemoved func(i Item)
 }
 
@@ -64,6 +66,9 @@ func (i *Inventory) Update(delta int64) {
 	for _, it := range i.Items() {
 		it.Update(delta)
 	}
+	if i.Lock() != nil {
+		i.Lock().Update(delta)
+	}
 }
 
 // Items returns all items in inventory.
@@ -91,7 +96,11 @@ func (i *Inventory) Item(id, serial string) *InventoryItem {
 }
 
 // LootItem returns loot item.
+// Returns nil if the inventory is locked.
 func (i *Inventory) LootItem(id, serial string) Item {
+	if i.Locked() {
+		return nil
+	}
 	for _, it := range i.Items() {
 		if it.ID() == id && it.Serial() == serial {
 			return it
@@ -100,6 +109,36 @@ func (i *Inventory) LootItem(id, serial string) Item {
 	return nil
 }
 
+// TradeItem returns item for trade.
+// Returns nil if the inventory is locked or item is
+// not for trade.
+func (i *Inventory) TradeItem(id, serial string) Item {
+	if i.Locked() {
+		return nil
+	}
+	it := i.Item(id, serial)
+	if it == nil || !it.Trade {
+		return nil
+	}
+	return it
+}
+
+// Lock returns inventory lock or nil if inventory
+// has no lock.
+func (i *Inventory) Lock() *lock.Lock {
+	return i.lock
+}
+
+// SetLock sets specified lock for the inventory.
+func (i *Inventory) SetLock(l *lock.Lock) {
+	i.lock = l
+}
+
+// Locked checks if inventory is locked.
+func (i *Inventory) Locked() bool {
+	return i.Lock() != nil && i.Lock().Locked()
+}
+
 // AddItems add specified item to inventory.
 // Item will be marked as tradeable and lootable inside the inventory.
 // Trade value will be set as the same as item value.
@@ -164,6 +203,15 @@ func (i *Inventory) Apply(data res.InventoryData) {
 		}
 
 	}
+	// Lock.
+	switch {
+	case !lock.HasData(data.Lock):
+		i.lock = nil
+	case i.lock == nil:
+		i.lock = lock.New(data.Lock)
+	default:
+		i.lock.Apply(data.Lock)
+	}
 }
 
 // Data creates data resource for inventory.
@@ -180,6 +228,9 @@ func (i *Inventory) Data() res.InventoryData {
 		}
 		data.Items = append(data.Items, invItemData)
 	}
+	if i.Lock() != nil {
+		data.Lock = i.Lock().Data()
+	}
 	return data
 }
 
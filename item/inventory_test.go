@@ -1,7 +1,7 @@
 /*
  * inventory_test.go
  *
- * Copyright 2023 Dariusz Sikora <ds@isangeles.dev>
+ * Copyright 2023-2026 Dariusz Sikora <ds@isangeles.dev>
  *
  * This program is free software; you can redistribute it and/or modify
  * it under the terms of the GNU General Public License as published by
@@ -60,3 +60,31 @@ func TestInventoryApply(t *testing.T) {
 		t.Errorf("Invalid trade value: %d != 10", item.Price)
 	}
 }
+
+// TestInventoryLocked tests looting and trading items from
+// locked inventory.
+func TestInventoryLocked(t *testing.T) {
+	// Add test item to resources base.
+	res.Miscs = append(res.Miscs, res.MiscItemData{ID: "item"})
+	// Create inventory.
+	inv := NewInventory()
+	data := res.InventoryData{
+		Items: []res.InventoryItemData{invItemData},
+		Lock:  res.LockData{Locked: true, Key: "key"},
+	}
+	inv.Apply(data)
+	// Test.
+	if inv.LootItem(invItemData.ID, invItemData.Serial) != nil {
+		t.Errorf("Item looted from locked inventory")
+	}
+	if inv.TradeItem(invItemData.ID, invItemData.Serial) != nil {
+		t.Errorf("Item traded from locked inventory")
+	}
+	if !inv.Data().Lock.Locked {
+		t.Errorf("Lock not saved in inventory data")
+	}
+	inv.Lock().Apply(res.LockData{Key: "key"})
+	if inv.LootItem(invItemData.ID, invItemData.Serial) == nil {
+		t.Errorf("Item not looted from unlocked inventory")
+	}
+}
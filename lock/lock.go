@@ -0,0 +1,217 @@
+/*
+ * lock.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+// Package with locks for containers and passages.
+package lock
+
+import (
+	"errors"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/req"
+	"github.com/isangeles/flame/rng"
+)
+
+var (
+	KEY_REQUIRED = errors.New("key required")
+	NOT_PICKABLE = errors.New("lock can't be picked")
+	PICK_FAILED  = errors.New("lockpicking failed")
+)
+
+// Interface for objects that can open locks.
+// Lockpicking returns the opener bonus added to the d20
+// roll checked against the lock difficulty on lockpicking,
+// for game characters it is the character dexterity.
+type Opener interface {
+	effect.Target
+	req.RequirementsTarget
+	Lockpicking() int
+}
+
+// Struct for lock.
+type Lock struct {
+	locked      bool
+	key         string
+	flag        string
+	difficulty  int
+	relock      int64
+	relockTime  int64
+	failMods    []effect.Modifier
+	failEffects []res.EffectData
+}
+
+// New creates new lock.
+func New(data res.LockData) *Lock {
+	l := new(Lock)
+	l.Apply(data)
+	return l
+}
+
+// Update updates lock.
+// Unlocked lock is locked again after relock time,
+// counted from the moment the lock was opened.
+func (l *Lock) Update(delta int64) {
+	if l.Locked() || l.relock < 1 || l.relockTime <= 0 {
+		return
+	}
+	l.relockTime -= delta
+	if l.relockTime <= 0 {
+		l.Lock()
+	}
+}
+
+// Locked checks if lock is locked.
+func (l *Lock) Locked() bool {
+	return l.locked
+}
+
+// Lock locks the lock.
+func (l *Lock) Lock() {
+	l.locked = true
+	l.relockTime = 0
+}
+
+// Unlock unlocks the lock if specified opener has
+// the lock key item or the lock flag.
+// Returns KEY_REQUIRED error if lock can't be opened
+// by specified opener.
+func (l *Lock) Unlock(o Opener) error {
+	if !l.Locked() {
+		return nil
+	}
+	if len(l.key) > 0 {
+		keyReq := req.NewItem(res.ItemReqData{ID: l.key, Amount: 1})
+		if o.MeetReqs(keyReq) {
+			l.open()
+			return nil
+		}
+	}
+	if len(l.flag) > 0 {
+		flagReq := req.NewFlag(res.IDReqData{ID: l.flag})
+		if o.MeetReqs(flagReq) {
+			l.open()
+			return nil
+		}
+	}
+	return KEY_REQUIRED
+}
+
+// Pick tries to pick the lock by specified opener.
+// Lockpicking roll of the opener is checked against the
+// lock difficulty, locks with difficulty lower than 1
+// can't be picked.
+// On failure, lock fail modifiers and effects are applied
+// on the opener.
+func (l *Lock) Pick(o Opener) error {
+	if !l.Locked() {
+		return nil
+	}
+	if l.difficulty < 1 {
+		return NOT_PICKABLE
+	}
+	if rng.RollInt(1, 20)+o.Lockpicking() < l.difficulty {
+		l.fail(o)
+		return PICK_FAILED
+	}
+	l.open()
+	return nil
+}
+
+// Difficulty returns lock difficulty.
+func (l *Lock) Difficulty() int {
+	return l.difficulty
+}
+
+// Key returns ID of the lock key item.
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// Flag returns ID of the flag that opens the lock.
+func (l *Lock) Flag() string {
+	return l.flag
+}
+
+// Apply applies specified data on the lock.
+func (l *Lock) Apply(data res.LockData) {
+	l.locked = data.Locked
+	l.key = data.Key
+	l.flag = data.Flag
+	l.difficulty = data.Difficulty
+	l.relock = data.Relock
+	l.relockTime = data.RelockTime
+	if !l.Locked() && l.relockTime <= 0 {
+		l.relockTime = l.relock
+	}
+	l.failMods = effect.NewModifiers(data.FailMods)
+	l.failEffects = nil
+	for _, ed := range data.FailEffects {
+		data := res.Effect(ed.ID)
+		if data == nil {
+			log.Err.Printf("lock: effect not found: %s", ed.ID)
+			continue
+		}
+		l.failEffects = append(l.failEffects, *data)
+	}
+}
+
+// Data returns data resource for the lock.
+func (l *Lock) Data() res.LockData {
+	data := res.LockData{
+		Locked:     l.locked,
+		Key:        l.key,
+		Flag:       l.flag,
+		Difficulty: l.difficulty,
+		Relock:     l.relock,
+		RelockTime: l.relockTime,
+		FailMods:   effect.ModifiersData(l.failMods...),
+	}
+	for _, ed := range l.failEffects {
+		data.FailEffects = append(data.FailEffects, res.UseActionEffectData{ed.ID})
+	}
+	return data
+}
+
+// open unlocks the lock and starts relock timer.
+func (l *Lock) open() {
+	l.locked = false
+	l.relockTime = l.relock
+}
+
+// fail applies fail modifiers and effects on specified
+// opener.
+func (l *Lock) fail(o Opener) {
+	o.TakeModifiers(nil, l.failMods...)
+	for _, ed := range l.failEffects {
+		o.TakeEffect(effect.New(ed))
+	}
+}
+
+// HasData checks if specified lock data describes
+// any lock.
+func HasData(data res.LockData) bool {
+	return data.Locked || len(data.Key) > 0 || len(data.Flag) > 0 ||
+		data.Difficulty > 0
+}
@@ -0,0 +1,178 @@
+/*
+ * lock_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package lock
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/req"
+	"github.com/isangeles/flame/serial"
+)
+
+var lockData = res.LockData{
+	Locked:     true,
+	Key:        "testItem",
+	Flag:       "flagKey",
+	Difficulty: 100,
+	Relock:     10,
+	FailMods: res.ModifiersData{
+		FlagMods: []res.FlagModData{{ID: "flagAlarm"}},
+	},
+}
+
+// Struct for test lock opener.
+type testOpener struct {
+	serial      string
+	items       []string
+	flags       []string
+	lockpicking int
+	mods        []effect.Modifier
+}
+
+// TestUnlockKey tests unlocking lock with the key item.
+func TestUnlockKey(t *testing.T) {
+	// Create lock & opener
+	l := New(lockData)
+	o := new(testOpener)
+	// Test
+	if err := l.Unlock(o); err != KEY_REQUIRED {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+	o.items = append(o.items, "testItem")
+	if err := l.Unlock(o); err != nil {
+		t.Errorf("Unable to unlock: %v", err)
+	}
+	if l.Locked() {
+		t.Errorf("Lock is still locked")
+	}
+	l.Update(10)
+	if !l.Locked() {
+		t.Errorf("Lock not relocked")
+	}
+}
+
+// TestUnlockFlag tests unlocking lock with the flag.
+func TestUnlockFlag(t *testing.T) {
+	// Create lock & opener
+	l := New(lockData)
+	o := &testOpener{flags: []string{"flagKey"}}
+	// Test
+	if err := l.Unlock(o); err != nil {
+		t.Errorf("Unable to unlock: %v", err)
+	}
+	if l.Locked() {
+		t.Errorf("Lock is still locked")
+	}
+}
+
+// TestPickLock tests lockpicking.
+func TestPickLock(t *testing.T) {
+	// Create lock & opener
+	l := New(lockData)
+	o := new(testOpener)
+	// Test
+	if err := l.Pick(o); err != PICK_FAILED {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+	if len(o.mods) != 1 {
+		t.Errorf("Fail modifiers not applied")
+	}
+	data := lockData
+	data.Difficulty = 1
+	l.Apply(data)
+	if err := l.Pick(o); err != nil {
+		t.Errorf("Unable to pick lock: %v", err)
+	}
+	data.Difficulty = 0
+	l.Apply(data)
+	if err := l.Pick(o); err != NOT_PICKABLE {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+	data.Difficulty = 25
+	l.Apply(data)
+	o.lockpicking = 24
+	if err := l.Pick(o); err != nil {
+		t.Errorf("Unable to pick lock with lockpicking bonus: %v", err)
+	}
+}
+
+// TestRelock tests relocking unlocked lock.
+func TestRelock(t *testing.T) {
+	// Create lock
+	l := New(res.LockData{Relock: 10})
+	// Test
+	l.Update(5)
+	if l.Locked() {
+		t.Errorf("Lock relocked before relock time")
+	}
+	l.Update(5)
+	if !l.Locked() {
+		t.Errorf("Lock not relocked")
+	}
+	l.Update(10)
+	if !l.Locked() {
+		t.Errorf("Locked lock unlocked")
+	}
+}
+
+func (o *testOpener) ID() string                         { return "opener" }
+func (o *testOpener) Serial() string                     { return o.serial }
+func (o *testOpener) SetSerial(serial string)            { o.serial = serial }
+func (o *testOpener) SetPosition(x, y float64)           {}
+func (o *testOpener) Position() (float64, float64)       { return 0, 0 }
+func (o *testOpener) Effects() []*effect.Effect          { return nil }
+func (o *testOpener) HitEffects() []*effect.Effect       { return nil }
+func (o *testOpener) HitModifiers() []effect.Modifier    { return nil }
+func (o *testOpener) TakeEffect(e *effect.Effect)        {}
+func (o *testOpener) RemoveEffect(e *effect.Effect)      {}
+func (o *testOpener) Lockpicking() int                   { return o.lockpicking }
+func (o *testOpener) ChargeReqs(reqs ...req.Requirement) {}
+
+func (o *testOpener) TakeModifiers(s serial.Serialer, m ...effect.Modifier) {
+	o.mods = append(o.mods, m...)
+}
+
+func (o *testOpener) RemoveModifiers(s serial.Serialer, m ...effect.Modifier) {}
+
+func (o *testOpener) MeetReqs(reqs ...req.Requirement) bool {
+	for _, r := range reqs {
+		meet := false
+		switch r := r.(type) {
+		case *req.Item:
+			for _, id := range o.items {
+				meet = meet || id == r.ItemID()
+			}
+		case *req.Flag:
+			for _, id := range o.flags {
+				meet = meet || id == r.FlagID()
+			}
+		}
+		if !meet {
+			return false
+		}
+	}
+	return true
+}
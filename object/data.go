@@ -0,0 +1,111 @@
+/*
+ * data.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package object
+
+import (
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/useaction"
+)
+
+// Apply applies specified data on the object.
+func (o *Object) Apply(data res.ObjectData) {
+	o.id = data.ID
+	o.state = data.State
+	o.areaID = data.Area
+	o.radius = data.Radius
+	o.openLoot = data.OpenLoot
+	o.SetPosition(data.PosX, data.PosY)
+	o.action = nil
+	if useaction.HasData(data.Action) {
+		o.action = useaction.New(data.Action)
+	}
+	o.states = nil
+	for _, sd := range data.States {
+		s := State{id: sd.ID, passable: sd.Passable}
+		if useaction.HasData(sd.Action) {
+			s.action = useaction.New(sd.Action)
+		}
+		o.states = append(o.states, &s)
+	}
+	o.SetSerial(data.Serial)
+	o.Inventory().Apply(data.Inventory)
+	// Effects.
+	for _, objEffectData := range data.Effects {
+		_, ok := o.effects.Load(objEffectData.ID + objEffectData.Serial)
+		if ok {
+			continue
+		}
+		effectData := res.Effect(objEffectData.ID)
+		if effectData == nil {
+			log.Err.Printf("Object: %s: Apply: effect data not found: %s",
+				o.ID(), objEffectData.ID)
+			continue
+		}
+		e := effect.New(*effectData)
+		e.SetSerial(objEffectData.Serial)
+		e.SetTime(objEffectData.Time)
+		e.SetSource(objEffectData.SourceID, objEffectData.SourceSerial)
+		o.TakeEffect(e)
+	}
+}
+
+// Data returns data resource for the object.
+func (o *Object) Data() res.ObjectData {
+	data := res.ObjectData{
+		ID:        o.ID(),
+		Serial:    o.Serial(),
+		State:     o.State(),
+		Area:      o.AreaID(),
+		Radius:    o.CollisionRadius(),
+		OpenLoot:  o.OpenLoot(),
+		Restore:   true,
+		Inventory: o.Inventory().Data(),
+	}
+	data.PosX, data.PosY = o.Position()
+	if o.action != nil {
+		data.Action = o.action.Data()
+	}
+	for _, s := range o.States() {
+		stateData := res.ObjectStateData{
+			ID:       s.ID(),
+			Passable: s.Passable(),
+		}
+		if s.UseAction() != nil {
+			stateData.Action = s.UseAction().Data()
+		}
+		data.States = append(data.States, stateData)
+	}
+	for _, e := range o.Effects() {
+		effData := res.ObjectEffectData{
+			ID:     e.ID(),
+			Serial: e.Serial(),
+			Time:   e.Time(),
+		}
+		effData.SourceID, effData.SourceSerial = e.Source()
+		data.Effects = append(data.Effects, effData)
+	}
+	return data
+}
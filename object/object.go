@@ -0,0 +1,337 @@
+/*
+ * object.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+// Package with interactive static area objects,
+// like chests, doors or levers.
+package object
+
+import (
+	"sync"
+
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/effect"
+	"github.com/isangeles/flame/item"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/serial"
+	"github.com/isangeles/flame/useaction"
+)
+
+// Struct for interactive area object.
+type Object struct {
+	id, serial string
+	state      string
+	posX, posY float64
+	radius     float64
+	areaID     string
+	openLoot   bool
+	action     *useaction.UseAction
+	states     []*State
+	inventory  *item.Inventory
+	effects    *sync.Map
+}
+
+// Struct for area object state.
+type State struct {
+	id       string
+	passable bool
+	action   *useaction.UseAction
+}
+
+// New creates new area object.
+func New(data res.ObjectData) *Object {
+	o := Object{
+		inventory: item.NewInventory(),
+		effects:   new(sync.Map),
+	}
+	o.Apply(data)
+	serial.Register(&o)
+	return &o
+}
+
+// Update updates object.
+func (o *Object) Update(delta int64) {
+	if o.UseAction() != nil {
+		o.UseAction().Update(delta)
+	}
+	o.Inventory().Update(delta)
+	for _, e := range o.Effects() {
+		e.Update(delta)
+		// Remove expired effects.
+		if e.Time() <= 0 && !e.Infinite() {
+			o.effects.Delete(e.ID() + e.Serial())
+		}
+	}
+}
+
+// ID returns object ID.
+func (o *Object) ID() string {
+	return o.id
+}
+
+// Serial returns object serial value.
+func (o *Object) Serial() string {
+	return o.serial
+}
+
+// SetSerial sets specified serial value for the
+// object.
+func (o *Object) SetSerial(serial string) {
+	o.serial = serial
+	// Update ownerships.
+	if o.action != nil {
+		o.action.SetOwner(o)
+	}
+	for _, s := range o.states {
+		if s.action != nil {
+			s.action.SetOwner(o)
+		}
+	}
+}
+
+// State returns ID of the current object state.
+func (o *Object) State() string {
+	return o.state
+}
+
+// SetState sets state with specified ID as current
+// object state.
+func (o *Object) SetState(state string) {
+	o.state = state
+}
+
+// States returns all object states.
+func (o *Object) States() []*State {
+	return o.states
+}
+
+// UseAction returns use action for the current
+// object state, or default object use action if
+// the current state has no use action.
+func (o *Object) UseAction() *useaction.UseAction {
+	s := o.currentState()
+	if s != nil && s.action != nil {
+		return s.action
+	}
+	return o.action
+}
+
+// Position returns object position.
+func (o *Object) Position() (float64, float64) {
+	return o.posX, o.posY
+}
+
+// SetPosition sets object position.
+func (o *Object) SetPosition(x, y float64) {
+	o.posX, o.posY = x, y
+}
+
+// DestPoint returns object destination point.
+// Area objects are static, so destination point is
+// always the object position.
+func (o *Object) DestPoint() (float64, float64) {
+	return o.Position()
+}
+
+// SetDestPoint does nothing, area objects are static.
+func (o *Object) SetDestPoint(x, y float64) {}
+
+// Moving always returns false, area objects are static.
+func (o *Object) Moving() bool {
+	return false
+}
+
+// BaseMoveCooldown always returns 0, area objects
+// are static.
+func (o *Object) BaseMoveCooldown() int64 {
+	return 0
+}
+
+// MoveCooldown always returns 0, area objects are
+// static.
+func (o *Object) MoveCooldown() int64 {
+	return 0
+}
+
+// SetMoveCooldown does nothing, area objects are static.
+func (o *Object) SetMoveCooldown(c int64) {}
+
+// Interrupt does nothing, area objects do not perform
+// any actions.
+func (o *Object) Interrupt() {}
+
+// AreaID returns ID of the object area.
+func (o *Object) AreaID() string {
+	return o.areaID
+}
+
+// SetAreaID sets ID of the object area.
+func (o *Object) SetAreaID(id string) {
+	o.areaID = id
+}
+
+// Live always returns true, area objects can't be
+// killed.
+func (o *Object) Live() bool {
+	return true
+}
+
+// Respawn always returns 0, area objects can't be
+// killed.
+func (o *Object) Respawn() int64 {
+	return 0
+}
+
+// Despawn always returns 0, area objects are not
+// despawned.
+func (o *Object) Despawn() int64 {
+	return 0
+}
+
+// SightRange always returns 0, area objects can't see.
+func (o *Object) SightRange() float64 {
+	return 0
+}
+
+// OpenLoot checks if object inventory is open for
+// looting.
+func (o *Object) OpenLoot() bool {
+	return o.openLoot
+}
+
+// Inventory returns object inventory.
+func (o *Object) Inventory() *item.Inventory {
+	return o.inventory
+}
+
+// CollisionRadius returns radius of the object
+// collision circle.
+func (o *Object) CollisionRadius() float64 {
+	return o.radius
+}
+
+// Blocking checks if object blocks movement of
+// other objects in the current state.
+func (o *Object) Blocking() bool {
+	if o.radius <= 0 {
+		return false
+	}
+	s := o.currentState()
+	return s == nil || !s.passable
+}
+
+// Effects returns all object effects.
+func (o *Object) Effects() (effects []*effect.Effect) {
+	addEffect := func(k, v interface{}) bool {
+		e, ok := v.(*effect.Effect)
+		if ok {
+			effects = append(effects, e)
+		}
+		return true
+	}
+	o.effects.Range(addEffect)
+	return
+}
+
+// HitEffects returns nil, area objects do not hit.
+func (o *Object) HitEffects() []*effect.Effect {
+	return nil
+}
+
+// HitModifiers returns nil, area objects do not hit.
+func (o *Object) HitModifiers() []effect.Modifier {
+	return nil
+}
+
+// TakeEffect adds specified effect to the object.
+func (o *Object) TakeEffect(e *effect.Effect) {
+	e.SetTarget(o)
+	o.effects.Store(e.ID()+e.Serial(), e)
+}
+
+// RemoveEffect removes specified effect from the object.
+func (o *Object) RemoveEffect(e *effect.Effect) {
+	o.effects.Delete(e.ID() + e.Serial())
+}
+
+// TakeModifiers handles all specified modifiers.
+// Source can be nil.
+func (o *Object) TakeModifiers(source serial.Serialer, mods ...effect.Modifier) {
+	for _, m := range mods {
+		switch m := m.(type) {
+		case *effect.StateMod:
+			o.SetState(m.State())
+		case *effect.AddItemMod:
+			data := res.Item(m.ItemID())
+			if data == nil {
+				log.Err.Printf("object: %s %s: add item mod: data not found: %s",
+					o.ID(), o.Serial(), m.ItemID())
+				break
+			}
+			for i := 0; i < m.Amount(); i++ {
+				o.Inventory().AddItem(item.New(data))
+			}
+		case *effect.RemoveItemMod:
+			removed := 0
+			for _, it := range o.Inventory().Items() {
+				if removed >= m.Amount() {
+					break
+				}
+				if it.ID() == m.ItemID() {
+					o.Inventory().RemoveItem(it)
+					removed++
+				}
+			}
+		}
+	}
+}
+
+// RemoveModifiers does nothing, object modifiers are
+// not reversible.
+func (o *Object) RemoveModifiers(source serial.Serialer, mods ...effect.Modifier) {}
+
+// currentState returns current object state or nil
+// if object has no state with the current state ID.
+func (o *Object) currentState() *State {
+	for _, s := range o.states {
+		if s.ID() == o.State() {
+			return s
+		}
+	}
+	return nil
+}
+
+// ID returns state ID.
+func (s *State) ID() string {
+	return s.id
+}
+
+// Passable checks if object does not block movement
+// of other objects in this state.
+func (s *State) Passable() bool {
+	return s.passable
+}
+
+// UseAction returns state use action.
+func (s *State) UseAction() *useaction.UseAction {
+	return s.action
+}
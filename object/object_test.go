@@ -0,0 +1,120 @@
+/*
+ * object_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package object
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+)
+
+var (
+	charData   = res.CharacterData{ID: "char", Level: 1}
+	objectData = res.ObjectData{
+		ID:     "door",
+		State:  "closed",
+		Radius: 5,
+		States: []res.ObjectStateData{
+			{ID: "closed", Action: res.UseActionData{ObjectMods: res.ModifiersData{
+				StateMods: []res.StateModData{{State: "open"}},
+			}}},
+			{ID: "open", Passable: true, Action: res.UseActionData{ObjectMods: res.ModifiersData{
+				StateMods: []res.StateModData{{State: "closed"}},
+			}}},
+		},
+	}
+)
+
+// TestUseObject tests changing object state by using
+// the object.
+func TestUseObject(t *testing.T) {
+	// Create object & character
+	ob := New(objectData)
+	char := character.New(charData)
+	// Test
+	if !ob.Blocking() {
+		t.Errorf("Object in closed state is not blocking")
+	}
+	err := char.Use(ob)
+	if err != nil {
+		t.Fatalf("Unable to use object: %v", err)
+	}
+	char.Update(1)
+	if ob.State() != "open" {
+		t.Errorf("Invalid object state: %s != open", ob.State())
+	}
+	if ob.Blocking() {
+		t.Errorf("Object in open state is blocking")
+	}
+}
+
+// TestUseObjectRequirements tests using object with
+// requirements not meet.
+func TestUseObjectRequirements(t *testing.T) {
+	// Create object & character
+	data := objectData
+	data.States = []res.ObjectStateData{objectData.States[0], objectData.States[1]}
+	data.States[0].Action.Requirements = res.ReqsData{
+		FlagReqs: []res.IDReqData{{ID: "flagReq"}},
+	}
+	ob := New(data)
+	char := character.New(charData)
+	// Test
+	err := char.Use(ob)
+	if err != character.REQS_NOT_MEET {
+		t.Errorf("Invalid error returned: %v", err)
+	}
+	if ob.State() != "closed" {
+		t.Errorf("Invalid object state: %s != closed", ob.State())
+	}
+}
+
+// TestObjectData tests creating data resource for
+// the object.
+func TestObjectData(t *testing.T) {
+	// Create object
+	ob := New(objectData)
+	ob.SetState("open")
+	ob.SetPosition(10, 20)
+	// Test
+	data := ob.Data()
+	if !data.Restore {
+		t.Errorf("Restore flag not set")
+	}
+	if data.State != "open" {
+		t.Errorf("Invalid state: %s != open", data.State)
+	}
+	if data.PosX != 10 || data.PosY != 20 {
+		t.Errorf("Invalid position: %fx%f != 10x20", data.PosX, data.PosY)
+	}
+	if len(data.States) != len(objectData.States) {
+		t.Errorf("Invalid number of states: %d != %d", len(data.States),
+			len(objectData.States))
+	}
+	restored := New(data)
+	if restored.State() != "open" || restored.Blocking() {
+		t.Errorf("Invalid restored object state: %s", restored.State())
+	}
+}
@@ -0,0 +1,320 @@
+/*
+ * party.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+// Package for parties of characters.
+package party
+
+import (
+	"errors"
+	"math"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/log"
+	"github.com/isangeles/flame/quest"
+	"github.com/isangeles/flame/serial"
+)
+
+// Struct for party of characters.
+type Party struct {
+	id      string
+	max     int
+	spacing float64
+	members []*character.Character
+	invites []*character.Character
+	formed  bool
+}
+
+// Struct for quest of party member.
+type MemberQuest struct {
+	Member *character.Character
+	Quest  *quest.Quest
+}
+
+const (
+	defMax     = 5
+	defSpacing = 30
+)
+
+var (
+	PARTY_FULL     = errors.New("party is full")
+	ALREADY_MEMBER = errors.New("already a party member")
+	IN_PARTY       = errors.New("character is in another party")
+	NOT_INVITED    = errors.New("character not invited")
+	NOT_MEMBER     = errors.New("character is not a party member")
+)
+
+// New creates new party.
+func New(data res.PartyData) *Party {
+	p := new(Party)
+	p.Apply(data)
+	return p
+}
+
+// Update updates party.
+// Moves all party followers to their positions in the
+// formation around the party leader.
+func (p *Party) Update(delta int64) {
+	leader := p.Leader()
+	if leader == nil || !leader.Live() {
+		return
+	}
+	followers := p.members[1:]
+	for i, m := range followers {
+		if !m.Live() || m.Fighting() || m.AreaID() != leader.AreaID() {
+			continue
+		}
+		x, y := p.formationPosition(i, len(followers))
+		posX, posY := m.Position()
+		if math.Hypot(x-posX, y-posY) > p.Spacing() {
+			m.SetDestPoint(x, y)
+		}
+	}
+}
+
+// ID returns party ID.
+func (p *Party) ID() string {
+	return p.id
+}
+
+// Max returns maximal number of party members.
+func (p *Party) Max() int {
+	return p.max
+}
+
+// Spacing returns distance between the party leader
+// and followers in the formation.
+func (p *Party) Spacing() float64 {
+	return p.spacing
+}
+
+// Leader returns party leader or nil if the party
+// has no members.
+func (p *Party) Leader() *character.Character {
+	if len(p.members) < 1 {
+		return nil
+	}
+	return p.members[0]
+}
+
+// SetLeader sets specified party member as the party leader.
+func (p *Party) SetLeader(char *character.Character) error {
+	i := p.memberIndex(char)
+	if i < 0 {
+		return NOT_MEMBER
+	}
+	p.members[0], p.members[i] = p.members[i], p.members[0]
+	return nil
+}
+
+// Members returns all party members.
+func (p *Party) Members() []*character.Character {
+	return append([]*character.Character{}, p.members...)
+}
+
+// Invites returns all invited characters.
+func (p *Party) Invites() []*character.Character {
+	return append([]*character.Character{}, p.invites...)
+}
+
+// Disbanded checks if the party had members and
+// all of them left the party.
+func (p *Party) Disbanded() bool {
+	return p.formed && len(p.members) < 1
+}
+
+// Invite invites specified character to the party.
+func (p *Party) Invite(char *character.Character) error {
+	if p.memberIndex(char) > -1 {
+		return ALREADY_MEMBER
+	}
+	if char.Party() != nil {
+		return IN_PARTY
+	}
+	if len(p.members) >= p.Max() {
+		return PARTY_FULL
+	}
+	if p.inviteIndex(char) < 0 {
+		p.invites = append(p.invites, char)
+	}
+	return nil
+}
+
+// Join adds specified character to the party.
+// Character needs to be invited first, unless the
+// party has no members yet.
+func (p *Party) Join(char *character.Character) error {
+	if p.memberIndex(char) > -1 {
+		return ALREADY_MEMBER
+	}
+	if char.Party() != nil {
+		return IN_PARTY
+	}
+	i := p.inviteIndex(char)
+	if i < 0 && len(p.members) > 0 {
+		return NOT_INVITED
+	}
+	if len(p.members) >= p.Max() {
+		return PARTY_FULL
+	}
+	if i > -1 {
+		p.invites = remove(p.invites, i)
+	}
+	p.members = append(p.members, char)
+	p.formed = true
+	char.SetParty(p)
+	return nil
+}
+
+// Decline removes invite for specified character.
+func (p *Party) Decline(char *character.Character) {
+	if i := p.inviteIndex(char); i > -1 {
+		p.invites = remove(p.invites, i)
+	}
+}
+
+// Leave removes specified character from the party.
+// If the leader leaves the party, the next member becomes
+// the new party leader.
+func (p *Party) Leave(char *character.Character) error {
+	i := p.memberIndex(char)
+	if i < 0 {
+		return NOT_MEMBER
+	}
+	p.members = remove(p.members, i)
+	char.SetParty(nil)
+	return nil
+}
+
+// Disband removes all members and invites from the party.
+func (p *Party) Disband() {
+	for _, m := range p.members {
+		m.SetParty(nil)
+	}
+	p.members = nil
+	p.invites = nil
+}
+
+// Quests returns quests of all party members.
+func (p *Party) Quests() (quests []MemberQuest) {
+	for _, m := range p.members {
+		for _, q := range m.Journal().Quests() {
+			quests = append(quests, MemberQuest{m, q})
+		}
+	}
+	return
+}
+
+// Apply applies specified data on the party.
+func (p *Party) Apply(data res.PartyData) {
+	p.id = data.ID
+	p.max = data.Max
+	if p.max < 1 {
+		p.max = defMax
+	}
+	p.spacing = data.Spacing
+	if p.spacing <= 0 {
+		p.spacing = defSpacing
+	}
+	p.Disband()
+	for _, md := range data.Members {
+		char := p.character(md)
+		if char == nil {
+			continue
+		}
+		p.members = append(p.members, char)
+		char.SetParty(p)
+	}
+	p.formed = len(p.members) > 0
+	for _, id := range data.Invites {
+		if char := p.character(id); char != nil {
+			p.invites = append(p.invites, char)
+		}
+	}
+}
+
+// Data returns data resource for the party.
+func (p *Party) Data() res.PartyData {
+	data := res.PartyData{
+		ID:      p.ID(),
+		Max:     p.Max(),
+		Spacing: p.Spacing(),
+	}
+	for _, m := range p.members {
+		data.Members = append(data.Members, res.SerialObjectData{m.ID(), m.Serial()})
+	}
+	for _, c := range p.invites {
+		data.Invites = append(data.Invites, res.SerialObjectData{c.ID(), c.Serial()})
+	}
+	return data
+}
+
+// formationPosition returns position of the follower with
+// specified index in formation around the party leader.
+func (p *Party) formationPosition(i, followers int) (float64, float64) {
+	x, y := p.Leader().Position()
+	angle := math.Pi/2 + 2*math.Pi*float64(i)/float64(followers)
+	return x + math.Cos(angle)*p.Spacing(), y + math.Sin(angle)*p.Spacing()
+}
+
+// memberIndex returns index of specified character
+// in party members or -1 if character is not a member.
+func (p *Party) memberIndex(char *character.Character) int {
+	for i, m := range p.members {
+		if m == char {
+			return i
+		}
+	}
+	return -1
+}
+
+// inviteIndex returns index of specified character
+// in party invites or -1 if character is not invited.
+func (p *Party) inviteIndex(char *character.Character) int {
+	for i, c := range p.invites {
+		if c == char {
+			return i
+		}
+	}
+	return -1
+}
+
+// remove returns copy of specified characters slice
+// without character with specified index.
+func remove(chars []*character.Character, i int) []*character.Character {
+	removed := make([]*character.Character, 0, len(chars)-1)
+	removed = append(removed, chars[:i]...)
+	return append(removed, chars[i+1:]...)
+}
+
+// character returns registered character with ID and serial
+// from specified data.
+func (p *Party) character(data res.SerialObjectData) *character.Character {
+	char, ok := serial.Object(data.ID, data.Serial).(*character.Character)
+	if !ok {
+		log.Err.Printf("party: %s: character not found: %s %s", p.ID(),
+			data.ID, data.Serial)
+		return nil
+	}
+	return char
+}
@@ -0,0 +1,156 @@
+/*
+ * party_test.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package party
+
+import (
+	"testing"
+
+	"github.com/isangeles/flame/character"
+	"github.com/isangeles/flame/data/res"
+	"github.com/isangeles/flame/req"
+)
+
+var (
+	charData  = res.CharacterData{ID: "char", Level: 1}
+	partyData = res.PartyData{ID: "party", Max: 3}
+)
+
+// TestPartyMembers tests inviting, joining and leaving
+// the party.
+func TestPartyMembers(t *testing.T) {
+	// Create test objects
+	p := New(partyData)
+	leader := character.New(charData)
+	member := character.New(charData)
+	other := character.New(charData)
+	last := character.New(charData)
+	// Test
+	if err := p.Join(leader); err != nil {
+		t.Fatalf("Unable to join empty party: %v", err)
+	}
+	if err := p.Join(member); err != NOT_INVITED {
+		t.Errorf("Invalid join error: %v != %v", err, NOT_INVITED)
+	}
+	p.Invite(member)
+	p.Invite(other)
+	p.Invite(last)
+	if err := p.Join(member); err != nil {
+		t.Errorf("Unable to join party: %v", err)
+	}
+	p.Join(other)
+	if err := p.Join(last); err != PARTY_FULL {
+		t.Errorf("Invalid join error: %v != %v", err, PARTY_FULL)
+	}
+	if len(p.Members()) != 3 || member.Party() != p {
+		t.Errorf("Invalid party members: %v", p.Members())
+	}
+	if err := p.Invite(other); err != ALREADY_MEMBER {
+		t.Errorf("Invalid invite error: %v != %v", err, ALREADY_MEMBER)
+	}
+	members := p.Members()
+	p.Leave(leader)
+	if len(members) != 3 || members[0] != leader || members[1] != member ||
+		members[2] != other {
+		t.Errorf("Members slice changed after member left: %v", members)
+	}
+	if p.Leader() != member {
+		t.Errorf("Invalid party leader after leader left: %v != %v", p.Leader(), member)
+	}
+	if leader.Party() != nil {
+		t.Errorf("Party not removed from character")
+	}
+	if err := p.SetLeader(leader); err != NOT_MEMBER {
+		t.Errorf("Invalid set leader error: %v != %v", err, NOT_MEMBER)
+	}
+	if p.Disbanded() {
+		t.Errorf("Party with members disbanded")
+	}
+	p.Leave(member)
+	p.Leave(other)
+	if !p.Disbanded() {
+		t.Errorf("Party without members not disbanded")
+	}
+}
+
+// TestPartyExperience tests sharing kill experience
+// between party members.
+func TestPartyExperience(t *testing.T) {
+	// Create test objects
+	p := New(partyData)
+	leader := character.New(charData)
+	member := character.New(charData)
+	far := character.New(charData)
+	far.SetAreaID("far")
+	for _, c := range []*character.Character{leader, member, far} {
+		c.SetHealth(c.MaxHealth())
+		p.Invite(c)
+		p.Join(c)
+	}
+	// Test
+	leader.AddKill(res.KillData{ID: "enemy", Experience: 100})
+	if leader.Experience() != 50 || member.Experience() != 50 {
+		t.Errorf("Invalid shared experience: %d %d != 50 50", leader.Experience(),
+			member.Experience())
+	}
+	if far.Experience() != 0 {
+		t.Errorf("Experience shared with member outside area: %d", far.Experience())
+	}
+	partyReq := req.NewParty(res.PartyReqData{Members: 3, Area: true})
+	if leader.MeetReqs(partyReq) {
+		t.Errorf("Party requirement meet with member outside area")
+	}
+	far.SetAreaID(leader.AreaID())
+	if !leader.MeetReqs(partyReq) {
+		t.Errorf("Party requirement not meet")
+	}
+}
+
+// TestPartyUpdate tests moving party followers in formation.
+func TestPartyUpdate(t *testing.T) {
+	// Create test objects
+	p := New(partyData)
+	leader := character.New(charData)
+	follower := character.New(charData)
+	for _, c := range []*character.Character{leader, follower} {
+		c.SetHealth(c.MaxHealth())
+		p.Invite(c)
+		p.Join(c)
+	}
+	leader.SetPosition(200, 200)
+	// Test
+	p.Update(1)
+	x, y := follower.DestPoint()
+	if x != 200 || y != 200+p.Spacing() {
+		t.Errorf("Invalid follower destination: %f %f", x, y)
+	}
+	data := p.Data()
+	if len(data.Members) != 2 || data.Members[0].Serial != leader.Serial() {
+		t.Errorf("Invalid party data: %v", data)
+	}
+	p.Disband()
+	p.Apply(data)
+	if p.Leader() != leader || follower.Party() != p {
+		t.Errorf("Party not restored from data")
+	}
+}
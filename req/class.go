@@ -0,0 +1,71 @@
+/*
+ * class.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package req
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for class requirement.
+type Class struct {
+	id   string
+	off  bool
+	meet bool
+}
+
+// NewClass creates new class requirement.
+func NewClass(data res.IDReqData) *Class {
+	c := Class{
+		id:  data.ID,
+		off: data.Off,
+	}
+	return &c
+}
+
+// ID returns required class ID.
+func (c *Class) ID() string {
+	return c.id
+}
+
+// Off checks if character should not be
+// a member of the class.
+func (c *Class) Off() bool {
+	return c.off
+}
+
+// Meet checks if requirement is set as met.
+func (c *Class) Meet() bool {
+	return c.meet
+}
+
+// SetMeet sets requirement as meet/not meet.
+func (c *Class) SetMeet(meet bool) {
+	c.meet = meet
+}
+
+// Data returns data resource for requirement.
+func (c *Class) Data() res.IDReqData {
+	data := res.IDReqData{ID: c.id, Off: c.off}
+	return data
+}
@@ -0,0 +1,74 @@
+/*
+ * party.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package req
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for party requirement.
+type Party struct {
+	members int
+	area    bool
+	meet    bool
+}
+
+// NewParty creates new party requirement.
+func NewParty(data res.PartyReqData) *Party {
+	p := Party{
+		members: data.Members,
+		area:    data.Area,
+	}
+	return &p
+}
+
+// Members returns minimal number of party members.
+func (p *Party) Members() int {
+	return p.members
+}
+
+// SameArea checks if all party members should be
+// in the same area.
+func (p *Party) SameArea() bool {
+	return p.area
+}
+
+// Meet checks if requirement is set as met.
+func (p *Party) Meet() bool {
+	return p.meet
+}
+
+// SetMeet sets requirement as meet/not meet.
+func (p *Party) SetMeet(meet bool) {
+	p.meet = meet
+}
+
+// Data returns data resource for party requirement.
+func (p *Party) Data() res.PartyReqData {
+	data := res.PartyReqData{
+		Members: p.members,
+		Area:    p.area,
+	}
+	return data
+}
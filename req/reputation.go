@@ -0,0 +1,82 @@
+/*
+ * reputation.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package req
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for reputation requirement.
+type Reputation struct {
+	faction string
+	value   int
+	less    bool
+	meet    bool
+}
+
+// NewReputation creates new reputation requirement.
+func NewReputation(data res.ReputationReqData) *Reputation {
+	r := Reputation{
+		faction: data.Faction,
+		value:   data.Value,
+		less:    data.Less,
+	}
+	return &r
+}
+
+// FactionID returns ID of the faction.
+func (r *Reputation) FactionID() string {
+	return r.faction
+}
+
+// Value returns required reputation value.
+func (r *Reputation) Value() int {
+	return r.value
+}
+
+// Less checks if actual reputation value should be
+// lesser then required value.
+func (r *Reputation) Less() bool {
+	return r.less
+}
+
+// Meet checks if requirement is set as met.
+func (r *Reputation) Meet() bool {
+	return r.meet
+}
+
+// SetMeet sets requirement as meet/not meet.
+func (r *Reputation) SetMeet(meet bool) {
+	r.meet = meet
+}
+
+// Data returns data resource for reputation requirement.
+func (r *Reputation) Data() res.ReputationReqData {
+	data := res.ReputationReqData{
+		Faction: r.faction,
+		Value:   r.value,
+		Less:    r.less,
+	}
+	return data
+}
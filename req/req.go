@@ -63,6 +63,10 @@ func NewRequirements(data res.ReqsData) (reqs []Requirement) {
 		freq := NewFlag(d)
 		reqs = append(reqs, freq)
 	}
+	for _, d := range data.WorldFlagReqs {
+		wfreq := NewWorldFlag(d)
+		reqs = append(reqs, wfreq)
+	}
 	for _, d := range data.ItemReqs {
 		ireq := NewItem(d)
 		reqs = append(reqs, ireq)
@@ -111,6 +115,22 @@ func NewRequirements(data res.ReqsData) (reqs []Requirement) {
 		ereq := NewEffect(d)
 		reqs = append(reqs, ereq)
 	}
+	for _, d := range data.TimeOfDayReqs {
+		treq := NewTimeOfDay(d)
+		reqs = append(reqs, treq)
+	}
+	for _, d := range data.ReputationReqs {
+		rreq := NewReputation(d)
+		reqs = append(reqs, rreq)
+	}
+	for _, d := range data.PartyReqs {
+		preq := NewParty(d)
+		reqs = append(reqs, preq)
+	}
+	for _, d := range data.ClassReqs {
+		creq := NewClass(d)
+		reqs = append(reqs, creq)
+	}
 	return
 }
 
@@ -127,6 +147,9 @@ func RequirementsData(reqs ...Requirement) (data res.ReqsData) {
 		case *Flag:
 			d := r.Data()
 			data.FlagReqs = append(data.FlagReqs, d)
+		case *WorldFlag:
+			d := r.Data()
+			data.WorldFlagReqs = append(data.WorldFlagReqs, d)
 		case *Item:
 			d := r.Data()
 			data.ItemReqs = append(data.ItemReqs, d)
@@ -163,6 +186,18 @@ func RequirementsData(reqs ...Requirement) (data res.ReqsData) {
 		case *Effect:
 			d := r.Data()
 			data.EffectReqs = append(data.EffectReqs, d)
+		case *TimeOfDay:
+			d := r.Data()
+			data.TimeOfDayReqs = append(data.TimeOfDayReqs, d)
+		case *Reputation:
+			d := r.Data()
+			data.ReputationReqs = append(data.ReputationReqs, d)
+		case *Party:
+			d := r.Data()
+			data.PartyReqs = append(data.PartyReqs, d)
+		case *Class:
+			d := r.Data()
+			data.ClassReqs = append(data.ClassReqs, d)
 		}
 	}
 	return
@@ -0,0 +1,93 @@
+/*
+ * timeofday.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package req
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for time of day requirement.
+type TimeOfDay struct {
+	phase    string
+	from, to int
+	meet     bool
+}
+
+// NewTimeOfDay creates new time of day requirement.
+func NewTimeOfDay(data res.TimeOfDayReqData) *TimeOfDay {
+	tr := TimeOfDay{
+		phase: data.Phase,
+		from:  data.From,
+		to:    data.To,
+	}
+	return &tr
+}
+
+// Phase returns ID of required day phase.
+func (tr *TimeOfDay) Phase() string {
+	return tr.phase
+}
+
+// Hours returns range of required hours.
+func (tr *TimeOfDay) Hours() (int, int) {
+	return tr.from, tr.to
+}
+
+// MeetTime checks if specified day phase and hour
+// meet the requirement.
+// Range of hours can go past midnight, e.g. from 22
+// to 4.
+func (tr *TimeOfDay) MeetTime(phase string, hour int) bool {
+	if len(tr.phase) > 0 && tr.phase != phase {
+		return false
+	}
+	switch {
+	case tr.from == tr.to:
+		return true
+	case tr.from < tr.to:
+		return hour >= tr.from && hour < tr.to
+	default:
+		return hour >= tr.from || hour < tr.to
+	}
+}
+
+// Meet checks wheter requirement is set as meet.
+func (tr *TimeOfDay) Meet() bool {
+	return tr.meet
+}
+
+// SetMeet sets requirement as meet/not meet.
+func (tr *TimeOfDay) SetMeet(meet bool) {
+	tr.meet = meet
+}
+
+// Data returns data resource for requirement.
+func (tr *TimeOfDay) Data() res.TimeOfDayReqData {
+	data := res.TimeOfDayReqData{
+		Phase: tr.phase,
+		From:  tr.from,
+		To:    tr.to,
+	}
+	return data
+}
@@ -0,0 +1,73 @@
+/*
+ * worldflag.go
+ *
+ * Copyright 2026 Dariusz Sikora <ds@isangeles.dev>
+ *
+ * This program is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston,
+ * MA 02110-1301, USA.
+ *
+ *
+ */
+
+package req
+
+import (
+	"github.com/isangeles/flame/data/res"
+)
+
+// Struct for world flag requirement.
+type WorldFlag struct {
+	flagID  string
+	flagOff bool
+	meet    bool
+}
+
+// NewWorldFlag creates new world flag requirement.
+func NewWorldFlag(data res.IDReqData) *WorldFlag {
+	wfr := new(WorldFlag)
+	wfr.flagID = data.ID
+	wfr.flagOff = data.Off
+	return wfr
+}
+
+// FlagID returns ID of required world flag.
+func (wfr *WorldFlag) FlagID() string {
+	return wfr.flagID
+}
+
+// FlagOff checks if world flag should be present
+// or not.
+func (wfr *WorldFlag) FlagOff() bool {
+	return wfr.flagOff
+}
+
+// Meet checks wheter requirement is set as meet.
+func (wfr *WorldFlag) Meet() bool {
+	return wfr.meet
+}
+
+// SetMeet sets requirement as meet/not meet.
+func (wfr *WorldFlag) SetMeet(meet bool) {
+	wfr.meet = meet
+}
+
+// Data returns data resource for requirement.
+func (wfr *WorldFlag) Data() res.IDReqData {
+	data := res.IDReqData{
+		ID:  wfr.FlagID(),
+		Off: wfr.FlagOff(),
+	}
+	return data
+}
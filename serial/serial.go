@@ -79,6 +79,20 @@ func Register(s Serialer) {
 	return
 }
 
+// Unregister removes specified object from registered
+// objects.
+func Unregister(s Serialer) {
+	obs, _ := objects.Load(s.ID())
+	serialers, _ := obs.([]Serialer)
+	registered := make([]Serialer, 0, len(serialers))
+	for _, ob := range serialers {
+		if ob != s {
+			registered = append(registered, ob)
+		}
+	}
+	objects.Store(s.ID(), registered)
+}
+
 // Object returns object with specified ID and
 // serial value or nil if no such object was
 // found among registered serial objects.
@@ -104,8 +118,8 @@ func Reset() {
 func uniqueSerial(group []Serialer) string {
 	// Choose unique serial value.
 	serial := len(group)
-	for i := serial; !unique(group, fmt.Sprintf("%d", i)); i++ {
-		serial = i
+	for !unique(group, fmt.Sprintf("%d", serial)) {
+		serial++
 	}
 	return fmt.Sprintf("%d", serial)
 }
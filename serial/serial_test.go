@@ -76,6 +76,24 @@ func TestReset(t *testing.T) {
 	}
 }
 
+// Tests unregister function.
+func TestUnregister(t *testing.T) {
+	ob1 := new(testObject)
+	ob2 := new(testObject)
+	ob3 := new(testObject)
+	ob1.id, ob2.id, ob3.id = "unregister", "unregister", "unregister"
+	Register(ob1)
+	Register(ob2)
+	Unregister(ob1)
+	if Object(ob1.ID(), ob1.Serial()) != nil {
+		t.Errorf("Object found after unregister: %s %s", ob1.ID(), ob1.Serial())
+	}
+	Register(ob3)
+	if ob3.Serial() == ob2.Serial() {
+		t.Errorf("Not unique serial values: %s == %s", ob3.Serial(), ob2.Serial())
+	}
+}
+
 // Tests concurrent calls on Register and Object functions.
 func TestConcurrentAccess(t *testing.T) {
 	add := func (){
@@ -191,6 +191,12 @@ func (ua *UseAction) TargetEffects() (effects []*effect.Effect) {
 	return
 }
 
+// TargetEffectsData returns data of use effects for user
+// target.
+func (ua *UseAction) TargetEffectsData() []res.EffectData {
+	return ua.targetEffects
+}
+
 // TargetUserEffects returns use effects for user target or user.
 func (ua *UseAction) TargetUserEffects() (effects []*effect.Effect) {
 	for _, ed := range ua.targetUserEffects {